@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipCmSyncStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipCmSyncStatusRead,
+		Schema: map[string]*schema.Schema{
+			"device_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Device group to report the status of in device_group_status",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Overall sync status of the device, for example `In Sync` or `Changes Pending`",
+			},
+			"color": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Color of the sync status as shown in the GUI",
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sync mode of the device, for example `standalone` or `high-availability`",
+			},
+			"summary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Summary of the sync status",
+			},
+			"details": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Status lines of each device and device group",
+			},
+			"device_group_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sync status of device_group",
+			},
+		},
+	}
+}
+
+func dataSourceBigipCmSyncStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Fetching Sync Status")
+
+	status, err := getCmSyncStatus(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Sync Status (%v) ", err)
+		return diag.FromErr(err)
+	}
+	d.SetId("sync-status")
+	_ = d.Set("status", status.Status)
+	_ = d.Set("color", status.Color)
+	_ = d.Set("mode", status.Mode)
+	_ = d.Set("summary", status.Summary)
+	_ = d.Set("details", status.Details)
+	if group, ok := d.GetOk("device_group"); ok {
+		groupStatus, _ := status.deviceGroupStatus(group.(string))
+		_ = d.Set("device_group_status", groupStatus)
+	}
+	return nil
+}
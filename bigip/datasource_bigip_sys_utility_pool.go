@@ -0,0 +1,123 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipSysUtilityPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipSysUtilityPoolRead,
+		Schema: bigiqConnectionSchema(map[string]*schema.Schema{
+			"regkey": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Registration key of the utility license pool",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the utility license pool",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Activation status of the utility license pool",
+			},
+			"expires": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiry date of the utility license pool",
+			},
+			"offerings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Offerings of the utility license pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"licensed_devices": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of devices holding a license from the offering",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceBigipSysUtilityPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	regKey := d.Get("regkey").(string)
+	log.Println("[INFO] Fetching Utility License Pool " + regKey)
+
+	bigiq, err := connectBigIq(d)
+	if err != nil {
+		log.Printf("[ERROR] Connection to BIG-IQ failed (%v) ", err)
+		return diag.FromErr(err)
+	}
+	pool, offerings, err := getUtilityPoolStatus(bigiq, regKey)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Utility License Pool (%s) (%v) ", regKey, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(regKey)
+	_ = d.Set("name", pool.Name)
+	_ = d.Set("status", pool.Status)
+	_ = d.Set("expires", pool.ExpiresDateTime)
+	if err := d.Set("offerings", offerings); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving offerings to state for Utility License Pool (%s): %s", regKey, err))
+	}
+	return nil
+}
+
+func getUtilityPoolStatus(bigiq *bigip.BigIP, regKey string) (*utilityLicensePool, []interface{}, error) {
+	var pool utilityLicensePool
+	found, err := getTmEntity(bigiq, &pool, uriUtilityLicenses, regKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !found {
+		return nil, nil, fmt.Errorf("there is no utility license pool with registration key %s", regKey)
+	}
+	var offerings utilityOfferings
+	if _, err := getTmEntity(bigiq, &offerings, uriUtilityLicenses, regKey, "offerings"); err != nil {
+		return nil, nil, err
+	}
+	var result []interface{}
+	for _, o := range offerings.Items {
+		var members utilityLicenseMembers
+		if _, err := getTmEntity(bigiq, &members, uriUtilityLicenses, regKey, "offerings", o.ID, "members"); err != nil {
+			return nil, nil, err
+		}
+		result = append(result, map[string]interface{}{
+			"id":               o.ID,
+			"name":             o.Name,
+			"status":           o.Status,
+			"licensed_devices": len(members.Items),
+		})
+	}
+	return &pool, result, nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriVcmpGuest     = "vcmp/guest"
+	uriClusterMember = "sys/cluster/default"
+	uriHostInfo      = "sys/host-info"
+	uriSoftwareImage = "sys/software/image"
+	uriSoftwareHfix  = "sys/software/hotfix"
+)
+
+type vcmpGuests struct {
+	Items []bigip.VcmpGuest `json:"items"`
+}
+
+type sysCluster struct {
+	Members []struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	} `json:"members"`
+}
+
+type sysHostInfo struct {
+	Entries map[string]struct {
+		NestedStats struct {
+			Entries struct {
+				CPUCount struct {
+					Value int `json:"value"`
+				} `json:"cpuCount"`
+			} `json:"entries"`
+		} `json:"nestedStats"`
+	} `json:"entries"`
+}
+
+type sysSoftwareImage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Build   string `json:"build"`
+}
+
+type sysSoftwareImages struct {
+	Items []sysSoftwareImage `json:"items"`
+}
+
+type vcmpHostSlot struct {
+	Slot       int
+	CoresTotal int
+	CoresUsed  int
+	Guests     []string
+}
+
+type vcmpHostDisk struct {
+	Name  string
+	Slot  int
+	Guest string
+}
+
+type vcmpHost struct {
+	Slots    []vcmpHostSlot
+	Guests   []bigip.VcmpGuest
+	Images   []sysSoftwareImage
+	Hotfixes []sysSoftwareImage
+	Disks    []vcmpHostDisk
+}
+
+func dataSourceBigipVcmpHost() *schema.Resource {
+	imageSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceBigipVcmpHostRead,
+		Schema: map[string]*schema.Schema{
+			"slots": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Slots of the vCMP host with their core allocation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cores_total": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cores_used": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Cores allocated to guests that are provisioned or deployed on the slot",
+						},
+						"cores_available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"guests": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Software images present on the host, usable as initial_image of a guest",
+				Elem:        imageSchema,
+			},
+			"hotfixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hotfix images present on the host, usable as initial_hotfix of a guest",
+				Elem:        imageSchema,
+			},
+			"virtual_disks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Virtual disks on the host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"slot": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"guest": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Guest using the disk, empty for orphaned disks",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipVcmpHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Fetching vCMP Host inventory")
+
+	if err := checkModuleProvisioned(client, "vcmp"); err != nil {
+		return diag.FromErr(err)
+	}
+	host, err := getVcmpHost(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve vCMP Host inventory (%v) ", err)
+		return diag.FromErr(err)
+	}
+	var slots []interface{}
+	for _, s := range host.Slots {
+		slots = append(slots, map[string]interface{}{
+			"slot":            s.Slot,
+			"cores_total":     s.CoresTotal,
+			"cores_used":      s.CoresUsed,
+			"cores_available": s.CoresTotal - s.CoresUsed,
+			"guests":          s.Guests,
+		})
+	}
+	var disks []interface{}
+	for _, disk := range host.Disks {
+		disks = append(disks, map[string]interface{}{
+			"name":  disk.Name,
+			"slot":  disk.Slot,
+			"guest": disk.Guest,
+		})
+	}
+	d.SetId(client.Host)
+	if err := d.Set("slots", slots); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving slots to state for vCMP Host: %s", err))
+	}
+	_ = d.Set("images", flattenSoftwareImages(host.Images))
+	_ = d.Set("hotfixes", flattenSoftwareImages(host.Hotfixes))
+	if err := d.Set("virtual_disks", disks); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving virtual_disks to state for vCMP Host: %s", err))
+	}
+	return nil
+}
+
+func flattenSoftwareImages(images []sysSoftwareImage) []interface{} {
+	var result []interface{}
+	for _, image := range images {
+		result = append(result, map[string]interface{}{
+			"name":    image.Name,
+			"version": image.Version,
+			"build":   image.Build,
+		})
+	}
+	return result
+}
+
+// getVcmpHost collects slots, guests, images and virtual disks of a vCMP host.
+// Appliances have no cluster, they are reported as slot 1.
+func getVcmpHost(client *bigip.BigIP) (*vcmpHost, error) {
+	host := &vcmpHost{}
+
+	var cluster sysCluster
+	found, err := getTmEntity(client, &cluster, uriClusterMember)
+	if err != nil {
+		return nil, err
+	}
+	var slotIDs []int
+	for _, m := range cluster.Members {
+		if id, err := strconv.Atoi(m.Name); err == nil && m.Enabled {
+			slotIDs = append(slotIDs, id)
+		}
+	}
+	if !found || len(slotIDs) == 0 {
+		slotIDs = []int{1}
+	}
+	sort.Ints(slotIDs)
+
+	var hostInfo sysHostInfo
+	if _, err := getTmEntity(client, &hostInfo, uriHostInfo); err != nil {
+		return nil, err
+	}
+	cores := vcmpCoresPerSlot(&hostInfo)
+
+	var guests vcmpGuests
+	if _, err := getTmEntity(client, &guests, uriVcmpGuest); err != nil {
+		return nil, err
+	}
+	host.Guests = guests.Items
+	for _, id := range slotIDs {
+		slot := vcmpHostSlot{Slot: id, CoresTotal: cores}
+		for _, g := range guests.Items {
+			if g.State == "configured" || !containsInt(g.AssignedSlots, id) {
+				continue
+			}
+			slot.CoresUsed += g.CoresPerSlot
+			slot.Guests = append(slot.Guests, g.Name)
+		}
+		host.Slots = append(host.Slots, slot)
+	}
+
+	var images, hotfixes sysSoftwareImages
+	if _, err := getTmEntity(client, &images, uriSoftwareImage); err != nil {
+		return nil, err
+	}
+	if _, err := getTmEntity(client, &hotfixes, uriSoftwareHfix); err != nil {
+		return nil, err
+	}
+	host.Images = images.Items
+	host.Hotfixes = hotfixes.Items
+
+	disks, err := client.GetVcmpDisks()
+	if err != nil {
+		return nil, err
+	}
+	if disks != nil {
+		for _, disk := range disks.Disks {
+			host.Disks = append(host.Disks, parseVcmpDisk(disk.Name, guests.Items))
+		}
+	}
+	return host, nil
+}
+
+// vCMP allocates cores as pairs of hyperthreads, host-info reports the logical
+// CPUs of each blade. Blades of a chassis are assumed to be of the same type.
+func vcmpCoresPerSlot(hostInfo *sysHostInfo) int {
+	cpus := 0
+	for _, entry := range hostInfo.Entries {
+		count := entry.NestedStats.Entries.CPUCount.Value
+		if cpus == 0 || (count > 0 && count < cpus) {
+			cpus = count
+		}
+	}
+	return cpus / 2
+}
+
+// Virtual disks are listed per slot as <disk>/<slot>.
+func parseVcmpDisk(name string, guests []bigip.VcmpGuest) vcmpHostDisk {
+	disk := vcmpHostDisk{Name: name}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		if slot, err := strconv.Atoi(name[i+1:]); err == nil {
+			disk.Name, disk.Slot = name[:i], slot
+		}
+	}
+	for _, g := range guests {
+		if g.VirtualDisk == disk.Name {
+			disk.Guest = g.Name
+		}
+	}
+	return disk
+}
+
+func containsInt(list []int, value int) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
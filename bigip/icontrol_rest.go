@@ -0,0 +1,170 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The helpers below talk to iControl REST endpoints that go-bigip does not wrap
+// (or wraps with a broken path). Path segments are joined with "/"; segments
+// that are full paths such as /Common/name are rewritten to ~Common~name.
+
+func tmPath(parts ...string) string {
+	segments := make([]string, len(parts))
+	for i, p := range parts {
+		if strings.HasPrefix(p, "/") {
+			p = strings.ReplaceAll(p, "/", "~")
+		}
+		segments[i] = p
+	}
+	return strings.Join(segments, "/")
+}
+
+func tmRequest(client *bigip.BigIP, method string, body interface{}, parts ...string) ([]byte, error) {
+	req := &bigip.APIRequest{
+		Method:      method,
+		URL:         tmPath(parts...),
+		ContentType: "application/json",
+	}
+	if body != nil {
+		buffer := &bytes.Buffer{}
+		encoder := json.NewEncoder(buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(body); err != nil {
+			return nil, err
+		}
+		req.Body = strings.TrimRight(buffer.String(), "\n")
+	}
+	return client.APICall(req)
+}
+
+// getTmEntity populates e from the given path. It returns false (and no error)
+// when BIG-IP answers with a 404, so callers can drop the resource from state.
+func getTmEntity(client *bigip.BigIP, e interface{}, parts ...string) (bool, error) {
+	resp, err := tmRequest(client, "get", nil, parts...)
+	if err != nil {
+		var reqError bigip.RequestError
+		if json.Unmarshal(resp, &reqError) == nil && reqError.Code == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := json.Unmarshal(resp, e); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func createTmEntity(client *bigip.BigIP, body interface{}, parts ...string) error {
+	_, err := tmRequest(client, "post", body, parts...)
+	return err
+}
+
+func modifyTmEntity(client *bigip.BigIP, body interface{}, parts ...string) error {
+	_, err := tmRequest(client, "put", body, parts...)
+	return err
+}
+
+func patchTmEntity(client *bigip.BigIP, body interface{}, parts ...string) error {
+	_, err := tmRequest(client, "patch", body, parts...)
+	return err
+}
+
+func deleteTmEntity(client *bigip.BigIP, parts ...string) error {
+	_, err := tmRequest(client, "delete", nil, parts...)
+	return err
+}
+
+// emptyToNone and noneToEmpty map an unset reference to BIG-IP's "none". Most
+// go-bigip structs tag references with omitempty, so clearing one needs "none".
+func emptyToNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func noneToEmpty(s string) string {
+	if s == "none" {
+		return ""
+	}
+	return s
+}
+
+// profileChild describes a sub-collection object of a security profile that is
+// configured by the schema block key. config returns nil when the block does
+// not ask for the object.
+type profileChild struct {
+	key, collection string
+	config          func(list []interface{}) interface{}
+}
+
+// syncProfileChildren creates, modifies or removes the sub-objects of a
+// security profile so they match the configuration.
+func syncProfileChildren(client *bigip.BigIP, d *schema.ResourceData, uri, name string, update bool, children []profileChild) error {
+	child := profileChildName(name)
+	for _, c := range children {
+		if update && !d.HasChange(c.key) {
+			continue
+		}
+		o, n := d.GetChange(c.key)
+		config := c.config(n.([]interface{}))
+		existed := update && c.config(o.([]interface{})) != nil
+		var err error
+		switch {
+		case config != nil && existed:
+			err = patchTmEntity(client, config, uri, name, c.collection, child)
+		case config != nil:
+			err = createTmEntity(client, config, uri, name, c.collection)
+		case existed:
+			err = deleteTmEntity(client, uri, name, c.collection, child)
+		}
+		if err != nil {
+			log.Printf("[ERROR] Unable to Modify %s of (%s) (%v) ", c.collection, name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+func profileChildName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+func enabledDisabled(v bool) string {
+	if v {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// pollInterval is the delay between two checks of pollUntil.
+var pollInterval = 5 * time.Second
+
+// pollUntil calls check until it reports done or fails. It returns ctx.Err()
+// when ctx ends first, so resource timeouts and interrupts stop the polling.
+func pollUntil(ctx context.Context, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTmPath(t *testing.T) {
+	data := map[string][]string{
+		"sys/log-config/destination/ipfix/~Common~ipfix": {"sys/log-config/destination/ipfix", "/Common/ipfix"},
+		"auth/user/admin":                      {"auth/user", "admin"},
+		"gtm/wideip/a/~Common~www.example.com": {"gtm/wideip/a", "/Common/www.example.com"},
+	}
+	for expected, parts := range data {
+		assert.Equal(t, expected, tmPath(parts...))
+	}
+}
+
+func TestGetTmEntityNotFound(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/sys/log-config/destination/ipfix/~Common~missing", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested object was not found.","errorStack":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/log-config/destination/ipfix/~Common~found", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"found","poolName":"/Common/collectors","protocolVersion":"ipfix"}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	var ipfix bigip.LogIPFIX
+	found, err := getTmEntity(client, &ipfix, uriLogIPFIX, "/Common/missing")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	found, err = getTmEntity(client, &ipfix, uriLogIPFIX, "/Common/found")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "/Common/collectors", ipfix.PoolName)
+}
+
+func TestPollUntil(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	checks := 0
+	err := pollUntil(context.Background(), func() (bool, error) {
+		checks++
+		return checks == 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, checks)
+
+	err = pollUntil(context.Background(), func() (bool, error) {
+		return false, fmt.Errorf("failed")
+	})
+	assert.EqualError(t, err, "failed")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = pollUntil(ctx, func() (bool, error) {
+		return false, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func testCheckTmEntityExists(uri, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var entity map[string]interface{}
+		found, err := getTmEntity(client, &entity, uri, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s was not created ", uri, name)
+		}
+		return nil
+	}
+}
+
+func testCheckTmEntitiesDestroyed(resourceType, uri string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			var entity map[string]interface{}
+			found, err := getTmEntity(client, &entity, uri, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed ", uri, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
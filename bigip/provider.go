@@ -124,79 +124,129 @@ func Provider() *schema.Provider {
 			"bigip_fast_azure_service_discovery":  dataSourceBigipFastAzureServiceDiscovery(),
 			"bigip_fast_gce_service_discovery":    dataSourceBigipFastGceServiceDiscovery(),
 			"bigip_as3_device_information":        dataSourceBigipAs3(),
+			"bigip_sys_utility_pool":              dataSourceBigipSysUtilityPool(),
+			"bigip_vcmp_host":                     dataSourceBigipVcmpHost(),
+			"bigip_cm_sync_status":                dataSourceBigipCmSyncStatus(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"bigip_cm_device":                       resourceBigipCmDevice(),
-			"bigip_cm_devicegroup":                  resourceBigipCmDevicegroup(),
-			"bigip_net_route":                       resourceBigipNetRoute(),
-			"bigip_net_selfip":                      resourceBigipNetSelfIP(),
-			"bigip_net_vlan":                        resourceBigipNetVlan(),
-			"bigip_ltm_irule":                       resourceBigipLtmIRule(),
-			"bigip_ltm_datagroup":                   resourceBigipLtmDataGroup(),
-			"bigip_ltm_monitor":                     resourceBigipLtmMonitor(),
-			"bigip_ltm_node":                        resourceBigipLtmNode(),
-			"bigip_ltm_pool":                        resourceBigipLtmPool(),
-			"bigip_ltm_pool_attachment":             resourceBigipLtmPoolAttachment(),
-			"bigip_ltm_policy":                      resourceBigipLtmPolicy(),
-			"bigip_ltm_profile_fasthttp":            resourceBigipLtmProfileFasthttp(),
-			"bigip_ltm_profile_fastl4":              resourceBigipLtmProfileFastl4(),
-			"bigip_ltm_profile_http2":               resourceBigipLtmProfileHttp2(),
-			"bigip_ltm_profile_httpcompress":        resourceBigipLtmProfileHttpcompress(),
-			"bigip_ltm_profile_oneconnect":          resourceBigipLtmProfileOneconnect(),
-			"bigip_ltm_profile_tcp":                 resourceBigipLtmProfileTcp(),
-			"bigip_ltm_profile_ftp":                 resourceBigipLtmProfileFtp(),
-			"bigip_ltm_profile_http":                resourceBigipLtmProfileHttp(),
-			"bigip_ltm_profile_web_acceleration":    resourceBigipLtmProfileWebAcceleration(),
-			"bigip_ltm_persistence_profile_srcaddr": resourceBigipLtmPersistenceProfileSrcAddr(),
-			"bigip_ltm_persistence_profile_dstaddr": resourceBigipLtmPersistenceProfileDstAddr(),
-			"bigip_ltm_persistence_profile_ssl":     resourceBigipLtmPersistenceProfileSSL(),
-			"bigip_ltm_persistence_profile_cookie":  resourceBigipLtmPersistenceProfileCookie(),
-			"bigip_ltm_profile_server_ssl":          resourceBigipLtmProfileServerSsl(),
-			"bigip_ltm_profile_client_ssl":          resourceBigipLtmProfileClientSsl(),
-			"bigip_ltm_snat":                        resourceBigipLtmSnat(),
-			"bigip_ltm_snatpool":                    resourceBigipLtmSnatpool(),
-			"bigip_ltm_virtual_address":             resourceBigipLtmVirtualAddress(),
-			"bigip_ltm_virtual_server":              resourceBigipLtmVirtualServer(),
-			"bigip_ltm_ifile":                       resourceBigipLtmIfile(),
-			"bigip_sys_dns":                         resourceBigipSysDns(),
-			"bigip_sys_iapp":                        resourceBigipSysIapp(),
-			"bigip_sys_ntp":                         resourceBigipSysNtp(),
-			"bigip_sys_ocsp":                        resourceBigipSysOcsp(),
-			"bigip_sys_provision":                   resourceBigipSysProvision(),
-			"bigip_sys_ifile":                       resourceBigipSysIfile(),
-			"bigip_sys_snmp":                        resourceBigipSysSnmp(),
-			"bigip_sys_snmp_traps":                  resourceBigipSysSnmpTraps(),
-			"bigip_sys_bigiplicense":                resourceBigipSysBigiplicense(),
-			"bigip_as3":                             resourceBigipAs3(),
-			"bigip_do":                              resourceBigipDo(),
-			"bigip_fast_template":                   resourceBigipFastTemplate(),
-			"bigip_fast_application":                resourceBigipFastApp(),
-			"bigip_fast_http_app":                   resourceBigipHttpFastApp(),
-			"bigip_fast_https_app":                  resourceBigipFastHTTPSApp(),
-			"bigip_fast_tcp_app":                    resourceBigipFastTcpApp(),
-			"bigip_fast_udp_app":                    resourceBigipFastUdpApp(),
-			"bigip_ssl_certificate":                 resourceBigipSslCertificate(),
-			"bigip_ssl_key":                         resourceBigipSslKey(),
-			"bigip_ssl_key_cert":                    resourceBigipSSLKeyCert(),
-			"bigip_command":                         resourceBigipCommand(),
-			"bigip_common_license_manage_bigiq":     resourceBigiqLicenseManage(),
-			"bigip_bigiq_as3":                       resourceBigiqAs3(),
-			"bigip_event_service_discovery":         resourceServiceDiscovery(),
-			"bigip_traffic_selector":                resourceBigipTrafficselector(),
-			"bigip_ipsec_policy":                    resourceBigipIpsecPolicy(),
-			"bigip_net_tunnel":                      resourceBigipNetTunnel(),
-			"bigip_net_ike_peer":                    resourceBigipNetIkePeer(),
-			"bigip_ipsec_profile":                   resourceBigipIpsecProfile(),
-			"bigip_waf_policy":                      resourceBigipAwafPolicy(),
-			"bigip_vcmp_guest":                      resourceBigipVcmpGuest(),
-			"bigip_ltm_cipher_rule":                 resourceBigipLtmCipherRule(),
-			"bigip_ltm_cipher_group":                resourceBigipLtmCipherGroup(),
-			"bigip_partition":                       resourceBigipPartition(),
-			"bigip_ltm_request_log_profile":         resourceBigipLtmProfileRequestLog(),
-			"bigip_ltm_profile_bot_defense":         resourceBigipLtmProfileBotDefense(),
-			"bigip_ltm_profile_rewrite":             resourceBigipLtmRewriteProfile(),
-			"bigip_ltm_profile_rewrite_uri_rules":   resourceBigipLtmRewriteProfileUriRules(),
-			"bigip_saas_bot_defense_profile":        resourceBigipSaasBotDefenseProfile(),
+			"bigip_cm_device":                         resourceBigipCmDevice(),
+			"bigip_cm_devicegroup":                    resourceBigipCmDevicegroup(),
+			"bigip_net_route":                         resourceBigipNetRoute(),
+			"bigip_net_selfip":                        resourceBigipNetSelfIP(),
+			"bigip_net_vlan":                          resourceBigipNetVlan(),
+			"bigip_ltm_irule":                         resourceBigipLtmIRule(),
+			"bigip_ltm_datagroup":                     resourceBigipLtmDataGroup(),
+			"bigip_ltm_datagroup_external":            resourceBigipLtmDataGroupExternal(),
+			"bigip_ltm_datagroup_record":              resourceBigipLtmDataGroupRecord(),
+			"bigip_ltm_monitor":                       resourceBigipLtmMonitor(),
+			"bigip_ltm_node":                          resourceBigipLtmNode(),
+			"bigip_ltm_pool":                          resourceBigipLtmPool(),
+			"bigip_ltm_pool_attachment":               resourceBigipLtmPoolAttachment(),
+			"bigip_ltm_policy":                        resourceBigipLtmPolicy(),
+			"bigip_ltm_profile_fasthttp":              resourceBigipLtmProfileFasthttp(),
+			"bigip_ltm_profile_fastl4":                resourceBigipLtmProfileFastl4(),
+			"bigip_ltm_profile_http2":                 resourceBigipLtmProfileHttp2(),
+			"bigip_ltm_profile_httpcompress":          resourceBigipLtmProfileHttpcompress(),
+			"bigip_ltm_profile_oneconnect":            resourceBigipLtmProfileOneconnect(),
+			"bigip_ltm_profile_tcp":                   resourceBigipLtmProfileTcp(),
+			"bigip_ltm_profile_udp":                   resourceBigipLtmProfileUdp(),
+			"bigip_ltm_profile_websocket":             resourceBigipLtmProfileWebsocket(),
+			"bigip_ltm_profile_html":                  resourceBigipLtmProfileHtml(),
+			"bigip_ltm_profile_analytics":             resourceBigipLtmProfileAnalytics(),
+			"bigip_ltm_profile_request_adapt":         resourceBigipLtmProfileRequestAdapt(),
+			"bigip_ltm_profile_response_adapt":        resourceBigipLtmProfileResponseAdapt(),
+			"bigip_ltm_profile_icap":                  resourceBigipLtmProfileIcap(),
+			"bigip_ltm_profile_ftp":                   resourceBigipLtmProfileFtp(),
+			"bigip_ltm_profile_http":                  resourceBigipLtmProfileHttp(),
+			"bigip_ltm_profile_web_acceleration":      resourceBigipLtmProfileWebAcceleration(),
+			"bigip_ltm_persistence_profile_srcaddr":   resourceBigipLtmPersistenceProfileSrcAddr(),
+			"bigip_ltm_persistence_profile_dstaddr":   resourceBigipLtmPersistenceProfileDstAddr(),
+			"bigip_ltm_persistence_profile_ssl":       resourceBigipLtmPersistenceProfileSSL(),
+			"bigip_ltm_persistence_profile_cookie":    resourceBigipLtmPersistenceProfileCookie(),
+			"bigip_ltm_persistence_profile_hash":      resourceBigipLtmPersistenceProfileHash(),
+			"bigip_ltm_persistence_profile_universal": resourceBigipLtmPersistenceProfileUniversal(),
+			"bigip_ltm_persistence_profile_sip":       resourceBigipLtmPersistenceProfileSIP(),
+			"bigip_ltm_persistence_profile_msrdp":     resourceBigipLtmPersistenceProfileMSRDP(),
+			"bigip_ltm_persistence_profile_host":      resourceBigipLtmPersistenceProfileHost(),
+			"bigip_ltm_profile_server_ssl":            resourceBigipLtmProfileServerSsl(),
+			"bigip_ltm_profile_client_ssl":            resourceBigipLtmProfileClientSsl(),
+			"bigip_ltm_snat":                          resourceBigipLtmSnat(),
+			"bigip_ltm_snatpool":                      resourceBigipLtmSnatpool(),
+			"bigip_ltm_virtual_address":               resourceBigipLtmVirtualAddress(),
+			"bigip_ltm_virtual_server":                resourceBigipLtmVirtualServer(),
+			"bigip_ltm_ifile":                         resourceBigipLtmIfile(),
+			"bigip_sys_dns":                           resourceBigipSysDns(),
+			"bigip_sys_iapp":                          resourceBigipSysIapp(),
+			"bigip_sys_ntp":                           resourceBigipSysNtp(),
+			"bigip_sys_ocsp":                          resourceBigipSysOcsp(),
+			"bigip_sys_provision":                     resourceBigipSysProvision(),
+			"bigip_sys_ifile":                         resourceBigipSysIfile(),
+			"bigip_sys_snmp":                          resourceBigipSysSnmp(),
+			"bigip_sys_snmp_traps":                    resourceBigipSysSnmpTraps(),
+			"bigip_sys_bigiplicense":                  resourceBigipSysBigiplicense(),
+			"bigip_sys_log_ipfix":                     resourceBigipSysLogIPFIX(),
+			"bigip_sys_user":                          resourceBigipSysUser(),
+			"bigip_sys_auth_ldap":                     resourceBigipSysAuthLdap(),
+			"bigip_sys_auth_radius":                   resourceBigipSysAuthRadius(),
+			"bigip_sys_auth_radius_server":            resourceBigipSysAuthRadiusServer(),
+			"bigip_sys_auth_tacacs":                   resourceBigipSysAuthTacacs(),
+			"bigip_sys_auth_source":                   resourceBigipSysAuthSource(),
+			"bigip_sys_remote_role":                   resourceBigipSysRemoteRole(),
+			"bigip_as3":                               resourceBigipAs3(),
+			"bigip_do":                                resourceBigipDo(),
+			"bigip_fast_template":                     resourceBigipFastTemplate(),
+			"bigip_fast_application":                  resourceBigipFastApp(),
+			"bigip_fast_http_app":                     resourceBigipHttpFastApp(),
+			"bigip_fast_https_app":                    resourceBigipFastHTTPSApp(),
+			"bigip_fast_tcp_app":                      resourceBigipFastTcpApp(),
+			"bigip_fast_udp_app":                      resourceBigipFastUdpApp(),
+			"bigip_ssl_certificate":                   resourceBigipSslCertificate(),
+			"bigip_ssl_key":                           resourceBigipSslKey(),
+			"bigip_ssl_key_cert":                      resourceBigipSSLKeyCert(),
+			"bigip_command":                           resourceBigipCommand(),
+			"bigip_common_license_manage_bigiq":       resourceBigiqLicenseManage(),
+			"bigip_bigiq_as3":                         resourceBigiqAs3(),
+			"bigip_event_service_discovery":           resourceServiceDiscovery(),
+			"bigip_traffic_selector":                  resourceBigipTrafficselector(),
+			"bigip_ipsec_policy":                      resourceBigipIpsecPolicy(),
+			"bigip_net_tunnel":                        resourceBigipNetTunnel(),
+			"bigip_net_ike_peer":                      resourceBigipNetIkePeer(),
+			"bigip_ipsec_profile":                     resourceBigipIpsecProfile(),
+			"bigip_waf_policy":                        resourceBigipAwafPolicy(),
+			"bigip_vcmp_guest":                        resourceBigipVcmpGuest(),
+			"bigip_ltm_cipher_rule":                   resourceBigipLtmCipherRule(),
+			"bigip_ltm_cipher_group":                  resourceBigipLtmCipherGroup(),
+			"bigip_partition":                         resourceBigipPartition(),
+			"bigip_ltm_request_log_profile":           resourceBigipLtmProfileRequestLog(),
+			"bigip_ltm_profile_bot_defense":           resourceBigipLtmProfileBotDefense(),
+			"bigip_ltm_profile_rewrite":               resourceBigipLtmRewriteProfile(),
+			"bigip_ltm_profile_rewrite_uri_rules":     resourceBigipLtmRewriteProfileUriRules(),
+			"bigip_saas_bot_defense_profile":          resourceBigipSaasBotDefenseProfile(),
+			"bigip_gtm_datacenter":                    resourceBigipGtmDatacenter(),
+			"bigip_gtm_server":                        resourceBigipGtmServer(),
+			"bigip_gtm_pool":                          resourceBigipGtmPool(),
+			"bigip_gtm_wideip":                        resourceBigipGtmWideip(),
+			"bigip_gtm_monitor":                       resourceBigipGtmMonitor(),
+			"bigip_gtm_region":                        resourceBigipGtmRegion(),
+			"bigip_gtm_topology_record":               resourceBigipGtmTopologyRecord(),
+			"bigip_apm_access_profile":                resourceBigipApmAccessProfile(),
+			"bigip_apm_access_policy":                 resourceBigipApmAccessPolicy(),
+			"bigip_apm_webtop":                        resourceBigipApmWebtop(),
+			"bigip_apm_webtop_section":                resourceBigipApmWebtopSection(),
+			"bigip_apm_webtop_link":                   resourceBigipApmWebtopLink(),
+			"bigip_net_address_list":                  resourceBigipNetAddressList(),
+			"bigip_net_port_list":                     resourceBigipNetPortList(),
+			"bigip_afm_firewall_policy":               resourceBigipAfmFirewallPolicy(),
+			"bigip_afm_ip_intelligence_feed_list":     resourceBigipAfmIPIntelligenceFeedList(),
+			"bigip_afm_ip_intelligence_policy":        resourceBigipAfmIPIntelligencePolicy(),
+			"bigip_security_dos_profile":              resourceBigipSecurityDosProfile(),
+			"bigip_security_log_profile":              resourceBigipSecurityLogProfile(),
+			"bigip_sys_utility_license":               resourceBigipSysUtilityLicense(),
+			"bigip_vcmp_virtual_disk":                 resourceBigipVcmpVirtualDisk(),
+			"bigip_cm_traffic_group":                  resourceBigipCmTrafficGroup(),
+			"bigip_cm_ha_group":                       resourceBigipCmHaGroup(),
+			"bigip_cm_trust":                          resourceBigipCmTrust(),
+			"bigip_cm_config_sync":                    resourceBigipCmConfigSync(),
 		},
 	}
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -0,0 +1,339 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAfmFirewallPolicy = "security/firewall/policy"
+
+// go-bigip's FirewallPolicy only carries a link to the rules, so the policy is
+// written together with its rules. Sending the whole rule list on every change
+// keeps the rule order exactly as configured.
+type afmFirewallPolicy struct {
+	Name           string             `json:"name,omitempty"`
+	FullPath       string             `json:"fullPath,omitempty"`
+	Description    string             `json:"description"`
+	Rules          *[]afmFirewallRule `json:"rules,omitempty"`
+	RulesReference *afmFirewallRules  `json:"rulesReference,omitempty"`
+}
+
+type afmFirewallRules struct {
+	Items []afmFirewallRule `json:"items,omitempty"`
+}
+
+type afmFirewallRule struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Action      string               `json:"action"`
+	IpProtocol  string               `json:"ipProtocol,omitempty"`
+	Status      string               `json:"status,omitempty"`
+	Log         string               `json:"log,omitempty"`
+	Irule       string               `json:"irule,omitempty"`
+	Schedule    string               `json:"schedule,omitempty"`
+	Source      afmFirewallRuleMatch `json:"source"`
+	Destination afmFirewallRuleMatch `json:"destination"`
+}
+
+type afmFirewallRuleMatch struct {
+	Addresses    []netListItem `json:"addresses,omitempty"`
+	AddressLists []string      `json:"addressLists,omitempty"`
+	Ports        []netListItem `json:"ports,omitempty"`
+	PortLists    []string      `json:"portLists,omitempty"`
+	Vlans        []string      `json:"vlans,omitempty"`
+}
+
+func afmFirewallRuleMatchSchema(description string, withVlans bool) *schema.Schema {
+	match := map[string]*schema.Schema{
+		"addresses": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Addresses, subnets or address ranges matched",
+		},
+		"address_lists": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Address lists matched, see `bigip_net_address_list`",
+		},
+		"ports": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Ports or port ranges matched",
+		},
+		"port_lists": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Port lists matched, see `bigip_net_port_list`",
+		},
+	}
+	if withVlans {
+		match["vlans"] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "VLANs or tunnels the traffic arrives on",
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        &schema.Resource{Schema: match},
+	}
+}
+
+func resourceBigipAfmFirewallPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipAfmFirewallPolicyCreate,
+		ReadContext:   resourceBigipAfmFirewallPolicyRead,
+		UpdateContext: resourceBigipAfmFirewallPolicyUpdate,
+		DeleteContext: resourceBigipAfmFirewallPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Firewall Policy",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules of the policy, evaluated in order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the rule, unique within the policy",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User defined description",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"accept", "accept-decisively", "drop", "reject"}, false),
+							Description:  "Action taken on matching traffic",
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "any",
+							Description: "IP protocol matched, e.g. `tcp`, `udp` or `icmp`",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Enables the rule",
+						},
+						"log": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Log matches of the rule",
+						},
+						"irule": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "iRule run for matching traffic",
+						},
+						"schedule": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Schedule the rule is active in, only applies when the rule is enabled",
+						},
+						"source":      afmFirewallRuleMatchSchema("Match on the source of the traffic, anything when not set", true),
+						"destination": afmFirewallRuleMatchSchema("Match on the destination of the traffic, anything when not set", false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipAfmFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Firewall Policy:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "afm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getAfmFirewallPolicyConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriAfmFirewallPolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Firewall Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipAfmFirewallPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipAfmFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Firewall Policy " + name)
+
+	var policy afmFirewallPolicy
+	found, err := getTmEntity(client, &policy, uriAfmFirewallPolicy, tmPath(name)+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Firewall Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Firewall Policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", policy.FullPath)
+	_ = d.Set("description", policy.Description)
+
+	var rules []interface{}
+	if policy.RulesReference != nil {
+		for _, r := range policy.RulesReference.Items {
+			protocol := r.IpProtocol
+			if protocol == "" {
+				protocol = "any"
+			}
+			rules = append(rules, map[string]interface{}{
+				"name":        r.Name,
+				"description": r.Description,
+				"action":      r.Action,
+				"protocol":    protocol,
+				"enabled":     r.Status != "disabled",
+				"log":         r.Log == "yes",
+				"irule":       r.Irule,
+				"schedule":    r.Schedule,
+				"source":      flattenAfmFirewallRuleMatch(r.Source, true),
+				"destination": flattenAfmFirewallRuleMatch(r.Destination, false),
+			})
+		}
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving rule to state for Firewall Policy (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipAfmFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Firewall Policy:%+v ", name)
+
+	err := modifyTmEntity(client, getAfmFirewallPolicyConfig(d), uriAfmFirewallPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Firewall Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipAfmFirewallPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipAfmFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Firewall Policy " + name)
+
+	err := deleteTmEntity(client, uriAfmFirewallPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Firewall Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getAfmFirewallPolicyConfig(d *schema.ResourceData) *afmFirewallPolicy {
+	rules := []afmFirewallRule{}
+	for _, item := range d.Get("rule").([]interface{}) {
+		r := item.(map[string]interface{})
+		rule := afmFirewallRule{
+			Name:        r["name"].(string),
+			Description: r["description"].(string),
+			Action:      r["action"].(string),
+			Status:      "enabled",
+			Log:         "no",
+			Irule:       r["irule"].(string),
+			Schedule:    r["schedule"].(string),
+			Source:      expandAfmFirewallRuleMatch(r["source"].([]interface{})),
+			Destination: expandAfmFirewallRuleMatch(r["destination"].([]interface{})),
+		}
+		if protocol := r["protocol"].(string); protocol != "any" {
+			rule.IpProtocol = protocol
+		}
+		if rule.Schedule != "" {
+			rule.Status = "scheduled"
+		}
+		if !r["enabled"].(bool) {
+			rule.Status = "disabled"
+		}
+		if r["log"].(bool) {
+			rule.Log = "yes"
+		}
+		rules = append(rules, rule)
+	}
+	return &afmFirewallPolicy{
+		Description: d.Get("description").(string),
+		Rules:       &rules,
+	}
+}
+
+func expandAfmFirewallRuleMatch(list []interface{}) afmFirewallRuleMatch {
+	var match afmFirewallRuleMatch
+	if len(list) == 0 || list[0] == nil {
+		return match
+	}
+	m := list[0].(map[string]interface{})
+	match.Addresses = netListItems(setToStringSlice(m["addresses"].(*schema.Set)))
+	match.AddressLists = setToStringSlice(m["address_lists"].(*schema.Set))
+	match.Ports = netListItems(setToStringSlice(m["ports"].(*schema.Set)))
+	match.PortLists = setToStringSlice(m["port_lists"].(*schema.Set))
+	if vlans, ok := m["vlans"]; ok {
+		match.Vlans = setToStringSlice(vlans.(*schema.Set))
+	}
+	return match
+}
+
+func flattenAfmFirewallRuleMatch(match afmFirewallRuleMatch, withVlans bool) []interface{} {
+	if len(match.Addresses) == 0 && len(match.AddressLists) == 0 && len(match.Ports) == 0 && len(match.PortLists) == 0 && len(match.Vlans) == 0 {
+		return nil
+	}
+	m := map[string]interface{}{
+		"addresses":     netListItemNames(match.Addresses),
+		"address_lists": match.AddressLists,
+		"ports":         netListItemNames(match.Ports),
+		"port_lists":    match.PortLists,
+	}
+	if withVlans {
+		m["vlans"] = match.Vlans
+	}
+	return []interface{}{m}
+}
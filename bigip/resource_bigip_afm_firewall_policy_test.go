@@ -0,0 +1,84 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestAfmFirewallPolicyName = "/Common/test-firewall-policy"
+
+func TestAccBigipAfmFirewallPolicyCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_afm_firewall_policy", uriAfmFirewallPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: testAfmFirewallPolicyResource("drop"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriAfmFirewallPolicy, TestAfmFirewallPolicyName),
+					resource.TestCheckResourceAttr("bigip_afm_firewall_policy.test-policy", "name", TestAfmFirewallPolicyName),
+					resource.TestCheckResourceAttr("bigip_afm_firewall_policy.test-policy", "rule.#", "2"),
+					resource.TestCheckResourceAttr("bigip_afm_firewall_policy.test-policy", "rule.0.name", "allow-web"),
+					resource.TestCheckResourceAttr("bigip_afm_firewall_policy.test-policy", "rule.1.action", "drop"),
+				),
+			},
+			{
+				Config: testAfmFirewallPolicyResource("reject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_afm_firewall_policy.test-policy", "rule.1.action", "reject"),
+				),
+			},
+			{
+				ResourceName:      "bigip_afm_firewall_policy.test-policy",
+				ImportStateId:     TestAfmFirewallPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAfmFirewallPolicyResource(defaultAction string) string {
+	return fmt.Sprintf(`
+resource "bigip_net_address_list" "clients" {
+  name      = "/Common/test-fw-clients"
+  addresses = ["10.10.0.0/16"]
+}
+
+resource "bigip_net_port_list" "web" {
+  name  = "/Common/test-fw-web"
+  ports = ["80", "443"]
+}
+
+resource "bigip_afm_firewall_policy" "test-policy" {
+  name        = "%s"
+  description = "test firewall policy"
+  rule {
+    name     = "allow-web"
+    action   = "accept"
+    protocol = "tcp"
+    log      = true
+    source {
+      address_lists = [bigip_net_address_list.clients.name]
+    }
+    destination {
+      port_lists = [bigip_net_port_list.web.name]
+    }
+  }
+  rule {
+    name   = "default"
+    action = "%s"
+  }
+}
+`, TestAfmFirewallPolicyName, defaultAction)
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriIPIntelligenceFeedList = "security/ip-intelligence/feed-list"
+
+type ipIntelligenceFeedList struct {
+	Name        string               `json:"name,omitempty"`
+	FullPath    string               `json:"fullPath,omitempty"`
+	Description string               `json:"description"`
+	Feeds       []ipIntelligenceFeed `json:"feeds"`
+}
+
+type ipIntelligenceFeed struct {
+	Name                     string `json:"name"`
+	URL                      string `json:"url"`
+	PollInterval             int    `json:"pollInterval,omitempty"`
+	DefaultListType          string `json:"defaultListType,omitempty"`
+	DefaultBlacklistCategory string `json:"defaultBlacklistCategory,omitempty"`
+}
+
+func resourceBigipAfmIPIntelligenceFeedList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipAfmIPIntelligenceFeedListCreate,
+		ReadContext:   resourceBigipAfmIPIntelligenceFeedListRead,
+		UpdateContext: resourceBigipAfmIPIntelligenceFeedListUpdate,
+		DeleteContext: resourceBigipAfmIPIntelligenceFeedListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the IP Intelligence Feed List",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"feed": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Feeds BIG-IP polls for addresses",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the feed, unique within the list",
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https", "ftp"}),
+							Description:  "URL of the feed file, with lines in `address,mask,list type,category` format",
+						},
+						"poll_interval": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Minutes between polls of the feed",
+						},
+						"list_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "blacklist",
+							ValidateFunc: validation.StringInSlice([]string{"blacklist", "whitelist"}, false),
+							Description:  "List type of feed entries that do not set one",
+						},
+						"blacklist_category": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Blacklist category of feed entries that do not set one",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipAfmIPIntelligenceFeedListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating IP Intelligence Feed List:%+v ", name)
+
+	config := getAfmIPIntelligenceFeedListConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriIPIntelligenceFeedList)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create IP Intelligence Feed List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipAfmIPIntelligenceFeedListRead(ctx, d, meta)
+}
+
+func resourceBigipAfmIPIntelligenceFeedListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching IP Intelligence Feed List " + name)
+
+	var list ipIntelligenceFeedList
+	found, err := getTmEntity(client, &list, uriIPIntelligenceFeedList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve IP Intelligence Feed List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] IP Intelligence Feed List (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", list.FullPath)
+	_ = d.Set("description", list.Description)
+
+	var feeds []interface{}
+	for _, f := range list.Feeds {
+		feeds = append(feeds, map[string]interface{}{
+			"name":               f.Name,
+			"url":                f.URL,
+			"poll_interval":      f.PollInterval,
+			"list_type":          f.DefaultListType,
+			"blacklist_category": f.DefaultBlacklistCategory,
+		})
+	}
+	if err := d.Set("feed", feeds); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving feed to state for IP Intelligence Feed List (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipAfmIPIntelligenceFeedListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating IP Intelligence Feed List:%+v ", name)
+
+	err := patchTmEntity(client, getAfmIPIntelligenceFeedListConfig(d), uriIPIntelligenceFeedList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify IP Intelligence Feed List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipAfmIPIntelligenceFeedListRead(ctx, d, meta)
+}
+
+func resourceBigipAfmIPIntelligenceFeedListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting IP Intelligence Feed List " + name)
+
+	err := deleteTmEntity(client, uriIPIntelligenceFeedList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IP Intelligence Feed List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getAfmIPIntelligenceFeedListConfig(d *schema.ResourceData) *ipIntelligenceFeedList {
+	config := &ipIntelligenceFeedList{
+		Description: d.Get("description").(string),
+		Feeds:       []ipIntelligenceFeed{},
+	}
+	for _, item := range d.Get("feed").([]interface{}) {
+		f := item.(map[string]interface{})
+		config.Feeds = append(config.Feeds, ipIntelligenceFeed{
+			Name:                     f["name"].(string),
+			URL:                      f["url"].(string),
+			PollInterval:             f["poll_interval"].(int),
+			DefaultListType:          f["list_type"].(string),
+			DefaultBlacklistCategory: f["blacklist_category"].(string),
+		})
+	}
+	return config
+}
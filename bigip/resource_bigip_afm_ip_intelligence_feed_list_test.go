@@ -0,0 +1,75 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestIPIntelligenceFeedListName = "/Common/test-feed-list"
+
+// testIPIntelligenceFeedServer serves a small feed file in place of a real
+// reputation feed.
+func testIPIntelligenceFeedServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "192.0.2.10,32,bl,spam_sources\n198.51.100.0,24,bl,botnets\n")
+	}))
+}
+
+func TestAccBigipAfmIPIntelligenceFeedListCreate(t *testing.T) {
+	server := testIPIntelligenceFeedServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_afm_ip_intelligence_feed_list", uriIPIntelligenceFeedList),
+		Steps: []resource.TestStep{
+			{
+				Config: testIPIntelligenceFeedListResource(server.URL, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriIPIntelligenceFeedList, TestIPIntelligenceFeedListName),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_feed_list.test-feeds", "name", TestIPIntelligenceFeedListName),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_feed_list.test-feeds", "feed.0.url", server.URL),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_feed_list.test-feeds", "feed.0.poll_interval", "60"),
+				),
+			},
+			{
+				Config: testIPIntelligenceFeedListResource(server.URL, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_feed_list.test-feeds", "feed.0.poll_interval", "120"),
+				),
+			},
+			{
+				ResourceName:      "bigip_afm_ip_intelligence_feed_list.test-feeds",
+				ImportStateId:     TestIPIntelligenceFeedListName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIPIntelligenceFeedListResource(url string, pollInterval int) string {
+	return fmt.Sprintf(`
+resource "bigip_afm_ip_intelligence_feed_list" "test-feeds" {
+  name = "%s"
+  feed {
+    name               = "local-feed"
+    url                = "%s"
+    poll_interval      = %d
+    blacklist_category = "/Common/spam_sources"
+  }
+}
+`, TestIPIntelligenceFeedListName, url, pollInterval)
+}
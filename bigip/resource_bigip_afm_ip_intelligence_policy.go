@@ -0,0 +1,297 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriIPIntelligencePolicy       = "security/ip-intelligence/policy"
+	uriIPIntelligenceGlobalPolicy = "security/ip-intelligence/global-policy"
+)
+
+// go-bigip's IPIntelligencePolicy has no feed lists or blacklist categories, so
+// the policy is managed through the tm helpers.
+type ipIntelligencePolicy struct {
+	Name                            string                          `json:"name,omitempty"`
+	FullPath                        string                          `json:"fullPath,omitempty"`
+	Description                     string                          `json:"description"`
+	DefaultAction                   string                          `json:"defaultAction,omitempty"`
+	DefaultLogBlacklistHitOnly      string                          `json:"defaultLogBlacklistHitOnly,omitempty"`
+	DefaultLogBlacklistWhitelistHit string                          `json:"defaultLogBlacklistWhitelistHit,omitempty"`
+	FeedLists                       []string                        `json:"feedLists"`
+	BlacklistCategories             []ipIntelligenceBlacklistAction `json:"blacklistCategories"`
+}
+
+type ipIntelligenceBlacklistAction struct {
+	Name                     string `json:"name"`
+	Action                   string `json:"action,omitempty"`
+	LogBlacklistHitOnly      string `json:"logBlacklistHitOnly,omitempty"`
+	LogBlacklistWhitelistHit string `json:"logBlacklistWhitelistHit,omitempty"`
+	MatchDirectionOverride   string `json:"matchDirectionOverride,omitempty"`
+}
+
+type ipIntelligenceGlobalPolicy struct {
+	IPIntelligencePolicy string `json:"ipIntelligencePolicy"`
+}
+
+var ipIntelligenceLogSettings = []string{"use-policy-setting", "yes", "no"}
+
+func resourceBigipAfmIPIntelligencePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipAfmIPIntelligencePolicyCreate,
+		ReadContext:   resourceBigipAfmIPIntelligencePolicyRead,
+		UpdateContext: resourceBigipAfmIPIntelligencePolicyUpdate,
+		DeleteContext: resourceBigipAfmIPIntelligencePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the IP Intelligence Policy",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"default_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "drop",
+				ValidateFunc: validation.StringInSlice([]string{"accept", "drop"}, false),
+				Description:  "Action taken on blacklisted addresses of categories without their own action",
+			},
+			"default_log_blacklist_hit_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log matches of blacklisted addresses that are not also whitelisted",
+			},
+			"default_log_blacklist_whitelist_hit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log matches of addresses that are both blacklisted and whitelisted",
+			},
+			"feed_lists": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Feed lists used by the policy, see `bigip_afm_ip_intelligence_feed_list`",
+			},
+			"blacklist_category": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Category specific actions and logging",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateF5NameWithDirectory,
+							Description:  "Blacklist category, e.g. `/Common/botnets`",
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "use-policy-setting",
+							ValidateFunc: validation.StringInSlice([]string{"use-policy-setting", "accept", "drop"}, false),
+							Description:  "Action taken on addresses of the category",
+						},
+						"log_blacklist_hit_only": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "use-policy-setting",
+							ValidateFunc: validation.StringInSlice(ipIntelligenceLogSettings, false),
+							Description:  "Log matches of the category that are not also whitelisted",
+						},
+						"log_blacklist_whitelist_hit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "use-policy-setting",
+							ValidateFunc: validation.StringInSlice(ipIntelligenceLogSettings, false),
+							Description:  "Log matches of the category that are also whitelisted",
+						},
+						"match_direction": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"match-source", "match-destination", "match-source-and-destination"}, false),
+							Description:  "Addresses of the traffic checked against the category",
+						},
+					},
+				},
+			},
+			"global": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enforce the policy in the global context, for all traffic",
+			},
+		},
+	}
+}
+
+func resourceBigipAfmIPIntelligencePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating IP Intelligence Policy:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "afm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getAfmIPIntelligencePolicyConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriIPIntelligencePolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create IP Intelligence Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if d.Get("global").(bool) {
+		if err := setIPIntelligenceGlobalPolicy(client, name); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipAfmIPIntelligencePolicyRead(ctx, d, meta)
+}
+
+func resourceBigipAfmIPIntelligencePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching IP Intelligence Policy " + name)
+
+	var policy ipIntelligencePolicy
+	found, err := getTmEntity(client, &policy, uriIPIntelligencePolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve IP Intelligence Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] IP Intelligence Policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", policy.FullPath)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("default_action", policy.DefaultAction)
+	_ = d.Set("default_log_blacklist_hit_only", policy.DefaultLogBlacklistHitOnly == "yes")
+	_ = d.Set("default_log_blacklist_whitelist_hit", policy.DefaultLogBlacklistWhitelistHit == "yes")
+	_ = d.Set("feed_lists", policy.FeedLists)
+
+	var categories []interface{}
+	for _, c := range policy.BlacklistCategories {
+		categories = append(categories, map[string]interface{}{
+			"name":                        c.Name,
+			"action":                      c.Action,
+			"log_blacklist_hit_only":      c.LogBlacklistHitOnly,
+			"log_blacklist_whitelist_hit": c.LogBlacklistWhitelistHit,
+			"match_direction":             c.MatchDirectionOverride,
+		})
+	}
+	if err := d.Set("blacklist_category", categories); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving blacklist_category to state for IP Intelligence Policy (%s): %s", name, err))
+	}
+
+	var global ipIntelligenceGlobalPolicy
+	if _, err := getTmEntity(client, &global, uriIPIntelligenceGlobalPolicy); err != nil {
+		log.Printf("[ERROR] Unable to Retrieve IP Intelligence Global Policy (%v) ", err)
+		return diag.FromErr(err)
+	}
+	_ = d.Set("global", global.IPIntelligencePolicy == policy.FullPath)
+	return nil
+}
+
+func resourceBigipAfmIPIntelligencePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating IP Intelligence Policy:%+v ", name)
+
+	err := patchTmEntity(client, getAfmIPIntelligencePolicyConfig(d), uriIPIntelligencePolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify IP Intelligence Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if d.HasChange("global") {
+		global := "none"
+		if d.Get("global").(bool) {
+			global = name
+		}
+		if err := setIPIntelligenceGlobalPolicy(client, global); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipAfmIPIntelligencePolicyRead(ctx, d, meta)
+}
+
+func resourceBigipAfmIPIntelligencePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting IP Intelligence Policy " + name)
+
+	// A policy in use by the global context cannot be deleted.
+	if d.Get("global").(bool) {
+		if err := setIPIntelligenceGlobalPolicy(client, "none"); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	err := deleteTmEntity(client, uriIPIntelligencePolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IP Intelligence Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getAfmIPIntelligencePolicyConfig(d *schema.ResourceData) *ipIntelligencePolicy {
+	config := &ipIntelligencePolicy{
+		Description:                     d.Get("description").(string),
+		DefaultAction:                   d.Get("default_action").(string),
+		DefaultLogBlacklistHitOnly:      "no",
+		DefaultLogBlacklistWhitelistHit: "no",
+		FeedLists:                       setToStringSlice(d.Get("feed_lists").(*schema.Set)),
+		BlacklistCategories:             []ipIntelligenceBlacklistAction{},
+	}
+	if d.Get("default_log_blacklist_hit_only").(bool) {
+		config.DefaultLogBlacklistHitOnly = "yes"
+	}
+	if d.Get("default_log_blacklist_whitelist_hit").(bool) {
+		config.DefaultLogBlacklistWhitelistHit = "yes"
+	}
+	for _, item := range d.Get("blacklist_category").([]interface{}) {
+		c := item.(map[string]interface{})
+		config.BlacklistCategories = append(config.BlacklistCategories, ipIntelligenceBlacklistAction{
+			Name:                     c["name"].(string),
+			Action:                   c["action"].(string),
+			LogBlacklistHitOnly:      c["log_blacklist_hit_only"].(string),
+			LogBlacklistWhitelistHit: c["log_blacklist_whitelist_hit"].(string),
+			MatchDirectionOverride:   c["match_direction"].(string),
+		})
+	}
+	return config
+}
+
+func setIPIntelligenceGlobalPolicy(client *bigip.BigIP, name string) error {
+	err := patchTmEntity(client, &ipIntelligenceGlobalPolicy{IPIntelligencePolicy: name}, uriIPIntelligenceGlobalPolicy)
+	if err != nil {
+		log.Printf("[ERROR] Unable to set IP Intelligence Global Policy to (%s) (%v) ", name, err)
+	}
+	return err
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestIPIntelligencePolicyName = "/Common/test-ipi-policy"
+
+func TestAccBigipAfmIPIntelligencePolicyCreate(t *testing.T) {
+	server := testIPIntelligenceFeedServer()
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_afm_ip_intelligence_policy", uriIPIntelligencePolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: testIPIntelligencePolicyResource(server.URL, "drop", false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriIPIntelligencePolicy, TestIPIntelligencePolicyName),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "name", TestIPIntelligencePolicyName),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "feed_lists.#", "1"),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "blacklist_category.0.action", "drop"),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "global", "false"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "ip_intelligence_policy", TestIPIntelligencePolicyName),
+				),
+			},
+			{
+				Config: testIPIntelligencePolicyResource(server.URL, "accept", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "blacklist_category.0.action", "accept"),
+					resource.TestCheckResourceAttr("bigip_afm_ip_intelligence_policy.test-policy", "global", "true"),
+				),
+			},
+			{
+				ResourceName:      "bigip_afm_ip_intelligence_policy.test-policy",
+				ImportStateId:     TestIPIntelligencePolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "bigip_ltm_virtual_server.test-vs",
+				ImportStateId: "/Common/test-ipi-vs",
+				ImportState:   true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if policy := states[0].Attributes["ip_intelligence_policy"]; policy != TestIPIntelligencePolicyName {
+						return fmt.Errorf("imported ip_intelligence_policy is %q, expected %s", policy, TestIPIntelligencePolicyName)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testIPIntelligencePolicyResource(url, action string, global bool) string {
+	return fmt.Sprintf(`
+resource "bigip_afm_ip_intelligence_feed_list" "test-feeds" {
+  name = "/Common/test-ipi-feeds"
+  feed {
+    name               = "local-feed"
+    url                = "%s"
+    blacklist_category = "/Common/spam_sources"
+  }
+}
+
+resource "bigip_afm_ip_intelligence_policy" "test-policy" {
+  name                           = "%s"
+  default_action                 = "drop"
+  default_log_blacklist_hit_only = true
+  feed_lists                     = [bigip_afm_ip_intelligence_feed_list.test-feeds.name]
+  blacklist_category {
+    name   = "/Common/spam_sources"
+    action = "%s"
+  }
+  global = %t
+}
+
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name                   = "/Common/test-ipi-vs"
+  destination            = "10.255.255.12"
+  port                   = 80
+  ip_intelligence_policy = bigip_afm_ip_intelligence_policy.test-policy.name
+}
+`, url, TestIPIntelligencePolicyName, action, global)
+}
@@ -0,0 +1,795 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriApmAccessPolicy       = "apm/policy/access-policy"
+	uriApmPolicyItem         = "apm/policy/policy-item"
+	uriApmPolicyAgent        = "apm/policy/agent"
+	uriApmCustomizationGroup = "apm/policy/customization-group"
+)
+
+// apmPolicyItemKinds maps the item types of the resource to the BIG-IP agent
+// behind them. Agents with a customization group get one created with the
+// same name, the way the visual policy editor does.
+var apmPolicyItemKinds = map[string]struct {
+	agentType          string
+	itemType           string
+	caption            string
+	color              int
+	customizationGroup string
+}{
+	"logon-page":      {"logon-page", "action", "Logon Page", 1, "logon"},
+	"ad-auth":         {"aaa-active-directory", "action", "AD Auth", 1, ""},
+	"resource-assign": {"resource-assign", "action", "Advanced Resource Assign", 1, ""},
+	"allow":           {"ending-allow", "ending", "Allow", 1, ""},
+	"deny":            {"ending-deny", "ending", "Deny", 2, "logout"},
+}
+
+var apmPolicyItemTypes = []string{"logon-page", "ad-auth", "resource-assign", "allow", "deny"}
+
+type apmAccessPolicy struct {
+	Name          string                `json:"name,omitempty"`
+	Partition     string                `json:"partition,omitempty"`
+	FullPath      string                `json:"fullPath,omitempty"`
+	StartItem     string                `json:"startItem,omitempty"`
+	DefaultEnding string                `json:"defaultEnding,omitempty"`
+	Items         []apmAccessPolicyItem `json:"items"`
+}
+
+type apmAccessPolicyItem struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	Priority  int    `json:"priority"`
+}
+
+type apmPolicyItem struct {
+	Name      string               `json:"name,omitempty"`
+	Partition string               `json:"partition,omitempty"`
+	FullPath  string               `json:"fullPath,omitempty"`
+	Caption   string               `json:"caption,omitempty"`
+	Color     int                  `json:"color,omitempty"`
+	ItemType  string               `json:"itemType,omitempty"`
+	Agents    []apmPolicyItemAgent `json:"agents,omitempty"`
+	Rules     []apmPolicyItemRule  `json:"rules"`
+}
+
+type apmPolicyItemAgent struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	Type      string `json:"type"`
+}
+
+type apmPolicyItemRule struct {
+	Caption    string `json:"caption"`
+	Expression string `json:"expression,omitempty"`
+	NextItem   string `json:"nextItem"`
+}
+
+type apmPolicyAgent struct {
+	Name               string `json:"name,omitempty"`
+	Partition          string `json:"partition,omitempty"`
+	CustomizationGroup string `json:"customizationGroup,omitempty"`
+	Server             string `json:"server,omitempty"`
+	MaxLogonAttempt    int    `json:"maxLogonAttempt,omitempty"`
+	Type               string `json:"type,omitempty"`
+
+	Rules []apmResourceAssignRule `json:"rules,omitempty"`
+}
+
+type apmResourceAssignRule struct {
+	Webtop         string   `json:"webtop,omitempty"`
+	WebtopLinks    []string `json:"webtopLinks,omitempty"`
+	WebtopSections []string `json:"webtopSections,omitempty"`
+}
+
+type apmCustomizationGroup struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition"`
+	Type      string `json:"type"`
+}
+
+// apmPolicyFlowItem is one item of the policy as written in the configuration,
+// next items are referenced by their short names.
+type apmPolicyFlowItem struct {
+	Name               string
+	Type               string
+	Caption            string
+	AdServer           string
+	AdMaxLogonAttempts int
+	Webtop             string
+	WebtopLinks        []string
+	WebtopSections     []string
+	Branches           []apmPolicyItemRule
+	FallbackItem       string
+}
+
+func resourceBigipApmAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipApmAccessPolicyCreate,
+		ReadContext:   resourceBigipApmAccessPolicyRead,
+		UpdateContext: resourceBigipApmAccessPolicyUpdate,
+		DeleteContext: resourceBigipApmAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceBigipApmAccessPolicyCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the Access Policy",
+			},
+			"start_item": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Item the Start of the policy leads to",
+			},
+			"default_ending": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Ending used when a session leaves the policy unexpectedly, defaults to the first `deny` item",
+			},
+			"item": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Items of the policy",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "item name may only contain letters, numbers, _ or -"),
+							Description:  "Name of the item, unique within the policy",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(apmPolicyItemTypes, false),
+							Description:  "Type of the item",
+						},
+						"caption": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Caption shown in the visual policy editor",
+						},
+						"ad_server": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "Active Directory AAA server, for `ad-auth` items",
+						},
+						"ad_max_logon_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntBetween(1, 5),
+							Description:  "Logon attempts allowed, for `ad-auth` items",
+						},
+						"webtop": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "Webtop assigned to the session, for `resource-assign` items",
+						},
+						"webtop_links": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Webtop links assigned to the session, for `resource-assign` items",
+						},
+						"webtop_sections": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Webtop sections assigned to the session, for `resource-assign` items",
+						},
+						"branch": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Branches evaluated in order before the fallback branch, for `logon-page`, `ad-auth` and `resource-assign` items",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"caption": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Caption of the branch",
+									},
+									"expression": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tcl expression selecting the branch",
+									},
+									"next_item": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Item the branch leads to",
+									},
+								},
+							},
+						},
+						"fallback_item": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Item the fallback branch leads to, required for `logon-page`, `ad-auth` and `resource-assign` items",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipApmAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Access Policy:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "apm"); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncApmAccessPolicy(client, name, d, nil); err != nil {
+		log.Printf("[ERROR] Unable to Create Access Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipApmAccessPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipApmAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Access Policy " + name)
+
+	var policy apmAccessPolicy
+	found, err := getTmEntity(client, &policy, uriApmAccessPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Access Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Access Policy (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	partition, base := splitApmFullPath(name)
+	entry := apmPolicyItemName(base, "", "")
+
+	var configured []string
+	for _, item := range getApmPolicyFlowItems(d.Get("item").([]interface{})) {
+		configured = append(configured, item.Name)
+	}
+	byName := make(map[string]interface{})
+	var discovered []string
+	for _, ref := range policy.Items {
+		if ref.Name == entry {
+			var start apmPolicyItem
+			if _, err := getTmEntity(client, &start, uriApmPolicyItem, apmFullPath(partition, entry)); err != nil {
+				return diag.FromErr(err)
+			}
+			for _, rule := range start.Rules {
+				_ = d.Set("start_item", apmPolicyItemShortName(base, rule.NextItem))
+			}
+			continue
+		}
+		item, err := readApmPolicyFlowItem(client, partition, base, ref.Name)
+		if err != nil {
+			log.Printf("[ERROR] Unable to Retrieve Access Policy item (%s) (%v) ", ref.Name, err)
+			return diag.FromErr(err)
+		}
+		if item == nil {
+			continue
+		}
+		shortName := item["name"].(string)
+		byName[shortName] = item
+		if !contains(configured, shortName) {
+			discovered = append(discovered, shortName)
+		}
+	}
+	// Items keep the order of the configuration, items added outside of
+	// Terraform are appended so they show up in the plan.
+	var items []interface{}
+	for _, itemName := range append(configured, discovered...) {
+		if item, ok := byName[itemName]; ok {
+			items = append(items, item)
+		}
+	}
+	_ = d.Set("name", name)
+	if _, ok := d.GetOk("default_ending"); ok {
+		_ = d.Set("default_ending", apmPolicyItemShortName(base, policy.DefaultEnding))
+	}
+	if err := d.Set("item", items); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving item to state for Access Policy (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipApmAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Access Policy:%+v ", name)
+
+	o, n := d.GetChange("item")
+	stale := staleApmPolicyFlowItems(getApmPolicyFlowItems(o.([]interface{})), getApmPolicyFlowItems(n.([]interface{})))
+	if err := syncApmAccessPolicy(client, name, d, stale); err != nil {
+		log.Printf("[ERROR] Unable to Modify Access Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmAccessPolicyRead(ctx, d, meta)
+}
+
+func resourceBigipApmAccessPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Access Policy " + name)
+
+	err := deleteTmEntity(client, uriApmAccessPolicy, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Access Policy (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	partition, base := splitApmFullPath(name)
+	if err := deleteApmPolicyObject(client, uriApmPolicyItem, apmFullPath(partition, apmPolicyItemName(base, "", ""))); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, item := range getApmPolicyFlowItems(d.Get("item").([]interface{})) {
+		if err := deleteApmPolicyFlowItem(client, partition, base, item); err != nil {
+			log.Printf("[ERROR] Unable to Delete Access Policy item (%s) (%v) ", item.Name, err)
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceBigipApmAccessPolicyCustomizeDiff checks the flow at plan time, a
+// policy with dangling branches is accepted by BIG-IP but cannot be applied.
+func resourceBigipApmAccessPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("item") || !d.NewValueKnown("start_item") || !d.NewValueKnown("default_ending") {
+		return nil
+	}
+	items := getApmPolicyFlowItems(d.Get("item").([]interface{}))
+	_, err := validateApmPolicyFlow(d.Get("start_item").(string), d.Get("default_ending").(string), items)
+	return err
+}
+
+// validateApmPolicyFlow checks that all references between the items resolve
+// and returns the default ending to use.
+func validateApmPolicyFlow(start, defaultEnding string, items []apmPolicyFlowItem) (string, error) {
+	types := make(map[string]string)
+	var firstDeny, firstEnding string
+	for _, item := range items {
+		if _, ok := types[item.Name]; ok {
+			return "", fmt.Errorf("access policy item %s is defined more than once", item.Name)
+		}
+		types[item.Name] = item.Type
+		if apmPolicyItemKinds[item.Type].itemType == "ending" {
+			if firstEnding == "" {
+				firstEnding = item.Name
+			}
+			if firstDeny == "" && item.Type == "deny" {
+				firstDeny = item.Name
+			}
+		}
+	}
+	if _, ok := types[start]; !ok {
+		return "", fmt.Errorf("start_item %s is not an item of the access policy", start)
+	}
+	for _, item := range items {
+		isEnding := apmPolicyItemKinds[item.Type].itemType == "ending"
+		if isEnding && (len(item.Branches) > 0 || item.FallbackItem != "") {
+			return "", fmt.Errorf("access policy item %s is an ending and cannot have branches or a fallback_item", item.Name)
+		}
+		if !isEnding && item.FallbackItem == "" {
+			return "", fmt.Errorf("access policy item %s needs a fallback_item", item.Name)
+		}
+		if item.Type == "ad-auth" && item.AdServer == "" {
+			return "", fmt.Errorf("access policy item %s of type ad-auth needs an ad_server", item.Name)
+		}
+		if item.Type == "resource-assign" && item.Webtop == "" && len(item.WebtopLinks) == 0 && len(item.WebtopSections) == 0 {
+			return "", fmt.Errorf("access policy item %s of type resource-assign needs a webtop, webtop_links or webtop_sections", item.Name)
+		}
+		next := []string{item.FallbackItem}
+		for _, branch := range item.Branches {
+			next = append(next, branch.NextItem)
+		}
+		for _, n := range next {
+			if _, ok := types[n]; n != "" && !ok {
+				return "", fmt.Errorf("access policy item %s leads to %s, which is not an item of the access policy", item.Name, n)
+			}
+		}
+	}
+	if defaultEnding == "" {
+		defaultEnding = firstDeny
+		if defaultEnding == "" {
+			defaultEnding = firstEnding
+		}
+	}
+	if defaultEnding == "" {
+		return "", fmt.Errorf("access policy needs at least one allow or deny item")
+	}
+	if t, ok := types[defaultEnding]; !ok || apmPolicyItemKinds[t].itemType != "ending" {
+		return "", fmt.Errorf("default_ending %s is not an allow or deny item of the access policy", defaultEnding)
+	}
+	return defaultEnding, nil
+}
+
+// syncApmAccessPolicy writes the whole flow: agents and items are created or
+// updated first without branches, so items can lead to each other in any
+// order, then the branches, then the policy itself. Items in stale are
+// removed once the policy no longer references them, and every access profile
+// using the policy is applied at the end.
+func syncApmAccessPolicy(client *bigip.BigIP, name string, d *schema.ResourceData, stale []apmPolicyFlowItem) error {
+	partition, base := splitApmFullPath(name)
+	items := getApmPolicyFlowItems(d.Get("item").([]interface{}))
+	start := d.Get("start_item").(string)
+	defaultEnding, err := validateApmPolicyFlow(start, d.Get("default_ending").(string), items)
+	if err != nil {
+		return err
+	}
+	itemPath := func(shortName string) string {
+		for _, item := range items {
+			if item.Name == shortName {
+				return apmFullPath(partition, apmPolicyItemName(base, item.Type, item.Name))
+			}
+		}
+		return ""
+	}
+
+	entry := &apmPolicyItem{
+		Name:      apmPolicyItemName(base, "", ""),
+		Partition: partition,
+		Caption:   "Start",
+		Color:     1,
+		ItemType:  "entry",
+		Rules:     []apmPolicyItemRule{},
+	}
+	if err := upsertApmPolicyObject(client, entry, uriApmPolicyItem, apmFullPath(partition, entry.Name)); err != nil {
+		return err
+	}
+	// An item whose type changed keeps its item object when it stays an action
+	// or an ending, the agent of the old type is removed before the new agent
+	// is created under the same name.
+	used := make(map[string]bool)
+	for _, item := range items {
+		used[apmPolicyItemName(base, item.Type, item.Name)] = true
+	}
+	for _, item := range stale {
+		if used[apmPolicyItemName(base, item.Type, item.Name)] {
+			if err := detachApmPolicyFlowItemAgent(client, partition, base, item); err != nil {
+				return fmt.Errorf("error removing old agent of access policy item %s: %v", item.Name, err)
+			}
+		}
+	}
+	for _, item := range items {
+		if err := upsertApmPolicyFlowItem(client, partition, base, item); err != nil {
+			return fmt.Errorf("error writing access policy item %s: %v", item.Name, err)
+		}
+	}
+
+	entry.Rules = []apmPolicyItemRule{{Caption: "fallback", NextItem: itemPath(start)}}
+	if err := patchTmEntity(client, &apmPolicyItem{Rules: entry.Rules}, uriApmPolicyItem, apmFullPath(partition, entry.Name)); err != nil {
+		return err
+	}
+	for _, item := range items {
+		rules := []apmPolicyItemRule{}
+		for _, branch := range item.Branches {
+			rules = append(rules, apmPolicyItemRule{Caption: branch.Caption, Expression: branch.Expression, NextItem: itemPath(branch.NextItem)})
+		}
+		if item.FallbackItem != "" {
+			rules = append(rules, apmPolicyItemRule{Caption: "fallback", NextItem: itemPath(item.FallbackItem)})
+		}
+		itemName := apmFullPath(partition, apmPolicyItemName(base, item.Type, item.Name))
+		if err := patchTmEntity(client, &apmPolicyItem{Rules: rules}, uriApmPolicyItem, itemName); err != nil {
+			return fmt.Errorf("error writing branches of access policy item %s: %v", item.Name, err)
+		}
+	}
+
+	policy := &apmAccessPolicy{
+		Name:          base,
+		Partition:     partition,
+		StartItem:     apmFullPath(partition, entry.Name),
+		DefaultEnding: itemPath(defaultEnding),
+		Items:         []apmAccessPolicyItem{{Name: entry.Name, Partition: partition, Priority: 1}},
+	}
+	for i, item := range items {
+		itemName := apmPolicyItemName(base, item.Type, item.Name)
+		policy.Items = append(policy.Items, apmAccessPolicyItem{Name: itemName, Partition: partition, Priority: i + 2})
+	}
+	if err := upsertApmPolicyObject(client, policy, uriApmAccessPolicy, name); err != nil {
+		return err
+	}
+
+	for _, item := range stale {
+		if used[apmPolicyItemName(base, item.Type, item.Name)] {
+			continue
+		}
+		if err := deleteApmPolicyFlowItem(client, partition, base, item); err != nil {
+			return fmt.Errorf("error removing access policy item %s: %v", item.Name, err)
+		}
+	}
+	return applyApmAccessProfilesUsingPolicy(client, name)
+}
+
+func upsertApmPolicyFlowItem(client *bigip.BigIP, partition, base string, item apmPolicyFlowItem) error {
+	kind := apmPolicyItemKinds[item.Type]
+	itemName := apmPolicyItemName(base, item.Type, item.Name)
+	agentName := itemName + "_ag"
+
+	agent := &apmPolicyAgent{Name: agentName, Partition: partition}
+	if kind.customizationGroup != "" {
+		group := &apmCustomizationGroup{Name: agentName, Partition: partition, Type: kind.customizationGroup}
+		if err := upsertApmPolicyObject(client, group, uriApmCustomizationGroup, apmFullPath(partition, agentName)); err != nil {
+			return err
+		}
+		agent.CustomizationGroup = apmFullPath(partition, agentName)
+	}
+	if item.Type == "ad-auth" {
+		agent.Server = item.AdServer
+		agent.MaxLogonAttempt = item.AdMaxLogonAttempts
+		agent.Type = "auth"
+	}
+	if item.Type == "resource-assign" {
+		agent.Rules = []apmResourceAssignRule{{
+			Webtop:         item.Webtop,
+			WebtopLinks:    item.WebtopLinks,
+			WebtopSections: item.WebtopSections,
+		}}
+	}
+	if err := upsertApmPolicyObject(client, agent, uriApmPolicyAgent, kind.agentType, apmFullPath(partition, agentName)); err != nil {
+		return err
+	}
+
+	caption := item.Caption
+	if caption == "" {
+		caption = kind.caption
+	}
+	policyItem := &apmPolicyItem{
+		Name:      itemName,
+		Partition: partition,
+		Caption:   caption,
+		Color:     kind.color,
+		ItemType:  kind.itemType,
+		Agents:    []apmPolicyItemAgent{{Name: agentName, Partition: partition, Type: kind.agentType}},
+		Rules:     []apmPolicyItemRule{},
+	}
+	return upsertApmPolicyObject(client, policyItem, uriApmPolicyItem, apmFullPath(partition, itemName))
+}
+
+func deleteApmPolicyFlowItem(client *bigip.BigIP, partition, base string, item apmPolicyFlowItem) error {
+	itemName := apmFullPath(partition, apmPolicyItemName(base, item.Type, item.Name))
+	if err := deleteApmPolicyObject(client, uriApmPolicyItem, itemName); err != nil {
+		return err
+	}
+	return deleteApmPolicyFlowItemAgent(client, partition, base, item)
+}
+
+// detachApmPolicyFlowItemAgent empties the agents of the item object, so the
+// agent and customization group of the item can be deleted while the item
+// object itself is kept.
+func detachApmPolicyFlowItemAgent(client *bigip.BigIP, partition, base string, item apmPolicyFlowItem) error {
+	itemName := apmFullPath(partition, apmPolicyItemName(base, item.Type, item.Name))
+	detached := struct {
+		Agents []apmPolicyItemAgent `json:"agents"`
+	}{Agents: []apmPolicyItemAgent{}}
+	if err := patchTmEntity(client, &detached, uriApmPolicyItem, itemName); err != nil {
+		return err
+	}
+	return deleteApmPolicyFlowItemAgent(client, partition, base, item)
+}
+
+func deleteApmPolicyFlowItemAgent(client *bigip.BigIP, partition, base string, item apmPolicyFlowItem) error {
+	kind := apmPolicyItemKinds[item.Type]
+	agentName := apmFullPath(partition, apmPolicyItemName(base, item.Type, item.Name)) + "_ag"
+	if err := deleteApmPolicyObject(client, uriApmPolicyAgent, kind.agentType, agentName); err != nil {
+		return err
+	}
+	if kind.customizationGroup != "" {
+		return deleteApmPolicyObject(client, uriApmCustomizationGroup, agentName)
+	}
+	return nil
+}
+
+func readApmPolicyFlowItem(client *bigip.BigIP, partition, base, name string) (map[string]interface{}, error) {
+	var item apmPolicyItem
+	found, err := getTmEntity(client, &item, uriApmPolicyItem, apmFullPath(partition, name))
+	if err != nil || !found || len(item.Agents) == 0 {
+		return nil, err
+	}
+	var itemType string
+	for t, kind := range apmPolicyItemKinds {
+		if kind.agentType == item.Agents[0].Type {
+			itemType = t
+		}
+	}
+	if itemType == "" {
+		log.Printf("[WARN] Access Policy item %s uses agent type %s, which is not managed by Terraform", name, item.Agents[0].Type)
+		return nil, nil
+	}
+	flowItem := map[string]interface{}{
+		"name":                  apmPolicyItemShortName(base, name),
+		"type":                  itemType,
+		"caption":               item.Caption,
+		"ad_server":             "",
+		"ad_max_logon_attempts": 3,
+		"webtop":                "",
+		"webtop_links":          []string{},
+		"webtop_sections":       []string{},
+		"fallback_item":         "",
+	}
+	var branches []interface{}
+	for _, rule := range item.Rules {
+		if rule.Caption == "fallback" && rule.Expression == "" {
+			flowItem["fallback_item"] = apmPolicyItemShortName(base, rule.NextItem)
+			continue
+		}
+		branches = append(branches, map[string]interface{}{
+			"caption":    rule.Caption,
+			"expression": rule.Expression,
+			"next_item":  apmPolicyItemShortName(base, rule.NextItem),
+		})
+	}
+	flowItem["branch"] = branches
+	if itemType == "ad-auth" {
+		var agent apmPolicyAgent
+		if _, err := getTmEntity(client, &agent, uriApmPolicyAgent, "aaa-active-directory", apmFullPath(partition, item.Agents[0].Name)); err != nil {
+			return nil, err
+		}
+		flowItem["ad_server"] = agent.Server
+		flowItem["ad_max_logon_attempts"] = agent.MaxLogonAttempt
+	}
+	if itemType == "resource-assign" {
+		var agent apmPolicyAgent
+		if _, err := getTmEntity(client, &agent, uriApmPolicyAgent, "resource-assign", apmFullPath(partition, item.Agents[0].Name)); err != nil {
+			return nil, err
+		}
+		for _, rule := range agent.Rules {
+			flowItem["webtop"] = rule.Webtop
+			flowItem["webtop_links"] = rule.WebtopLinks
+			flowItem["webtop_sections"] = rule.WebtopSections
+		}
+	}
+	return flowItem, nil
+}
+
+// applyApmAccessProfilesUsingPolicy applies every access profile that runs
+// the policy, so policy changes take effect without a separate step.
+func applyApmAccessProfilesUsingPolicy(client *bigip.BigIP, name string) error {
+	profiles, err := client.AccessProfiles()
+	if err != nil {
+		return fmt.Errorf("error listing access profiles using access policy %s: %v", name, err)
+	}
+	for _, profile := range profiles.AccessProfiles {
+		if profile.AccessPolicy == name {
+			if err := applyApmAccessProfile(client, profile.FullPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func upsertApmPolicyObject(client *bigip.BigIP, body interface{}, parts ...string) error {
+	var existing map[string]interface{}
+	found, err := getTmEntity(client, &existing, parts...)
+	if err != nil {
+		return err
+	}
+	if found {
+		return patchTmEntity(client, body, parts...)
+	}
+	return createTmEntity(client, body, parts[:len(parts)-1]...)
+}
+
+// BIG-IP removes some item objects together with the policy, so objects that
+// are already gone are not an error.
+func deleteApmPolicyObject(client *bigip.BigIP, parts ...string) error {
+	resp, err := tmRequest(client, "delete", nil, parts...)
+	if err != nil {
+		var reqError bigip.RequestError
+		if json.Unmarshal(resp, &reqError) == nil && reqError.Code == 404 {
+			return nil
+		}
+	}
+	return err
+}
+
+// staleApmPolicyFlowItems returns the old items that are removed or whose type
+// changed, their objects are of the old type and have to be deleted.
+func staleApmPolicyFlowItems(old, current []apmPolicyFlowItem) []apmPolicyFlowItem {
+	wanted := make(map[string]string)
+	for _, item := range current {
+		wanted[item.Name] = item.Type
+	}
+	var stale []apmPolicyFlowItem
+	for _, item := range old {
+		if itemType, ok := wanted[item.Name]; !ok || itemType != item.Type {
+			stale = append(stale, item)
+		}
+	}
+	return stale
+}
+
+func getApmPolicyFlowItems(list []interface{}) []apmPolicyFlowItem {
+	var items []apmPolicyFlowItem
+	for _, v := range list {
+		m := v.(map[string]interface{})
+		item := apmPolicyFlowItem{
+			Name:               m["name"].(string),
+			Type:               m["type"].(string),
+			Caption:            m["caption"].(string),
+			AdServer:           m["ad_server"].(string),
+			AdMaxLogonAttempts: m["ad_max_logon_attempts"].(int),
+			Webtop:             m["webtop"].(string),
+			WebtopLinks:        listToStringSlice(m["webtop_links"].([]interface{})),
+			WebtopSections:     listToStringSlice(m["webtop_sections"].([]interface{})),
+			FallbackItem:       m["fallback_item"].(string),
+		}
+		for _, b := range m["branch"].([]interface{}) {
+			branch := b.(map[string]interface{})
+			item.Branches = append(item.Branches, apmPolicyItemRule{
+				Caption:    branch["caption"].(string),
+				Expression: branch["expression"].(string),
+				NextItem:   branch["next_item"].(string),
+			})
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// Item objects are named after the policy like the visual policy editor names
+// them: <policy>_ent for the start, <policy>_act_<item> for actions and
+// <policy>_end_<item> for endings.
+func apmPolicyItemName(base, itemType, name string) string {
+	if itemType == "" {
+		return base + "_ent"
+	}
+	if apmPolicyItemKinds[itemType].itemType == "ending" {
+		return base + "_end_" + name
+	}
+	return base + "_act_" + name
+}
+
+func apmPolicyItemShortName(base, itemName string) string {
+	itemName = itemName[strings.LastIndex(itemName, "/")+1:]
+	for _, prefix := range []string{base + "_act_", base + "_end_"} {
+		if strings.HasPrefix(itemName, prefix) {
+			return strings.TrimPrefix(itemName, prefix)
+		}
+	}
+	return itemName
+}
+
+func splitApmFullPath(name string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(name, "/"), "/", 2)
+	if len(parts) < 2 {
+		return "Common", parts[0]
+	}
+	return parts[0], parts[1]
+}
+
+func apmFullPath(partition, name string) string {
+	return "/" + partition + "/" + name
+}
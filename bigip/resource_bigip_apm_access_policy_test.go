@@ -0,0 +1,170 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestApmAccessPolicyName = "/Common/test-access-policy"
+
+func TestAccBigipApmAccessPolicyCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApmAccessPoliciesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testApmAccessPolicyResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApmObjectExists(uriApmAccessPolicy, TestApmAccessPolicyName),
+					testCheckApmObjectExists(uriApmPolicyItem, TestApmAccessPolicyName+"_act_logon"),
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "start_item", "logon"),
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "item.#", "3"),
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "item.0.caption", "Logon Page"),
+				),
+			},
+			{
+				Config: testApmAccessPolicyResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "item.#", "4"),
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "item.0.branch.0.next_item", "deny-locked"),
+				),
+			},
+			{
+				Config: testApmAccessPolicyResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_access_policy.test-policy", "item.#", "3"),
+					testCheckApmPolicyItemDestroyed(TestApmAccessPolicyName+"_end_deny-locked"),
+				),
+			},
+			{
+				ResourceName:      "bigip_apm_access_policy.test-policy",
+				ImportStateId:     TestApmAccessPolicyName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipApmAccessPolicyInvalidFlow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "bigip_apm_access_policy" "test-policy" {
+  name       = "/Common/test-access-policy-invalid"
+  start_item = "logon"
+  item {
+    name          = "logon"
+    type          = "logon-page"
+    fallback_item = "allow"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("access policy item logon leads to allow"),
+			},
+		},
+	})
+}
+
+func testCheckApmObjectExists(uri, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var entity map[string]interface{}
+		found, err := getTmEntity(client, &entity, uri, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s does not exist ", uri, name)
+		}
+		return nil
+	}
+}
+
+func testCheckApmPolicyItemDestroyed(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var item apmPolicyItem
+		found, err := getTmEntity(client, &item, uriApmPolicyItem, name)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Access Policy item %s not destroyed ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckApmAccessPoliciesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_apm_access_policy" {
+			continue
+		}
+		policy, err := client.GetAccessPolicy(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("Access Policy %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testApmAccessPolicyResource(lockout bool) string {
+	branch, lockoutItem := "", ""
+	if lockout {
+		branch = `
+    branch {
+      caption    = "Locked"
+      expression = "expr { [mcget {session.logon.last.username}] == \"locked\" }"
+      next_item  = "deny-locked"
+    }`
+		lockoutItem = `
+  item {
+    name    = "deny-locked"
+    type    = "deny"
+    caption = "Locked Out"
+  }`
+	}
+	return fmt.Sprintf(`
+resource "bigip_apm_access_policy" "test-policy" {
+  name       = "%s"
+  start_item = "logon"
+  item {
+    name          = "logon"
+    type          = "logon-page"%s
+    fallback_item = "allow"
+  }
+  item {
+    name = "allow"
+    type = "allow"
+  }
+  item {
+    name = "deny"
+    type = "deny"
+  }%s
+  default_ending = "deny"
+}
+`, TestApmAccessPolicyName, branch, lockoutItem)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testApmPolicyFlow() []apmPolicyFlowItem {
+	return []apmPolicyFlowItem{
+		{Name: "logon", Type: "logon-page", FallbackItem: "ad"},
+		{Name: "ad", Type: "ad-auth", AdServer: "/Common/ad", FallbackItem: "deny", Branches: []apmPolicyItemRule{
+			{Caption: "Successful", Expression: "expr {[mcget {session.ad.last.authresult}] == 1}", NextItem: "allow"},
+		}},
+		{Name: "allow", Type: "allow"},
+		{Name: "deny", Type: "deny"},
+	}
+}
+
+func TestValidateApmPolicyFlow(t *testing.T) {
+	ending, err := validateApmPolicyFlow("logon", "", testApmPolicyFlow())
+	assert.NoError(t, err)
+	assert.Equal(t, "deny", ending)
+
+	ending, err = validateApmPolicyFlow("logon", "allow", testApmPolicyFlow())
+	assert.NoError(t, err)
+	assert.Equal(t, "allow", ending)
+
+	_, err = validateApmPolicyFlow("start", "", testApmPolicyFlow())
+	assert.ErrorContains(t, err, "start_item start is not an item")
+
+	_, err = validateApmPolicyFlow("logon", "ad", testApmPolicyFlow())
+	assert.ErrorContains(t, err, "default_ending ad is not an allow or deny item")
+
+	items := testApmPolicyFlow()
+	items[1].Branches[0].NextItem = "welcome"
+	_, err = validateApmPolicyFlow("logon", "", items)
+	assert.ErrorContains(t, err, "access policy item ad leads to welcome")
+
+	items = testApmPolicyFlow()
+	items[0].FallbackItem = ""
+	_, err = validateApmPolicyFlow("logon", "", items)
+	assert.ErrorContains(t, err, "access policy item logon needs a fallback_item")
+
+	items = testApmPolicyFlow()
+	items[1].AdServer = ""
+	_, err = validateApmPolicyFlow("logon", "", items)
+	assert.ErrorContains(t, err, "needs an ad_server")
+
+	items = testApmPolicyFlow()
+	items[1].Branches[0].NextItem = "portal"
+	items = append(items, apmPolicyFlowItem{Name: "portal", Type: "resource-assign", FallbackItem: "allow"})
+	_, err = validateApmPolicyFlow("logon", "", items)
+	assert.ErrorContains(t, err, "needs a webtop, webtop_links or webtop_sections")
+	items[len(items)-1].Webtop = "/Common/portal"
+	_, err = validateApmPolicyFlow("logon", "", items)
+	assert.NoError(t, err)
+}
+
+func TestApmPolicyItemName(t *testing.T) {
+	assert.Equal(t, "vpn_ent", apmPolicyItemName("vpn", "", ""))
+	assert.Equal(t, "vpn_act_logon", apmPolicyItemName("vpn", "logon-page", "logon"))
+	assert.Equal(t, "vpn_end_deny", apmPolicyItemName("vpn", "deny", "deny"))
+	assert.Equal(t, "logon", apmPolicyItemShortName("vpn", "/Common/vpn_act_logon"))
+	assert.Equal(t, "deny", apmPolicyItemShortName("vpn", "vpn_end_deny"))
+
+	partition, base := splitApmFullPath("/Common/vpn")
+	assert.Equal(t, "Common", partition)
+	assert.Equal(t, "vpn", base)
+}
+
+func TestStaleApmPolicyFlowItems(t *testing.T) {
+	old := testApmPolicyFlow()
+	current := testApmPolicyFlow()[1:]
+	current[0].Type = "logon-page"
+
+	var stale []string
+	for _, item := range staleApmPolicyFlowItems(old, current) {
+		stale = append(stale, item.Name+":"+item.Type)
+	}
+	assert.Equal(t, []string{"logon:logon-page", "ad:ad-auth"}, stale)
+	assert.Empty(t, staleApmPolicyFlowItems(old, testApmPolicyFlow()))
+}
+
+func TestSyncApmAccessPolicyRetypedItem(t *testing.T) {
+	setup()
+	defer teardown()
+	var requests []string
+	mux.HandleFunc("/mgmt/tm/", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == "PATCH" && r.URL.Path == "/mgmt/tm/apm/policy/policy-item/~Common~vpn_act_step" && string(body) == `{"agents":[]}` {
+			requests = append(requests, "DETACH "+r.URL.Path)
+		} else {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/mgmt/tm/apm/profile/access" {
+			_, _ = fmt.Fprintf(w, `{"items":[]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipApmAccessPolicy().Schema, map[string]interface{}{
+		"name":       "/Common/vpn",
+		"start_item": "step",
+		"item": []interface{}{
+			map[string]interface{}{"name": "step", "type": "ad-auth", "ad_server": "/Common/ad", "fallback_item": "deny"},
+			map[string]interface{}{"name": "deny", "type": "deny"},
+		},
+	})
+	stale := []apmPolicyFlowItem{{Name: "step", Type: "logon-page"}}
+	assert.NoError(t, syncApmAccessPolicy(client, "/Common/vpn", d, stale))
+
+	index := func(request string) int {
+		for i, r := range requests {
+			if r == request {
+				return i
+			}
+		}
+		t.Fatalf("request %s not sent, got %v", request, requests)
+		return -1
+	}
+	detach := index("DETACH /mgmt/tm/apm/policy/policy-item/~Common~vpn_act_step")
+	oldAgent := index("DELETE /mgmt/tm/apm/policy/agent/logon-page/~Common~vpn_act_step_ag")
+	oldGroup := index("DELETE /mgmt/tm/apm/policy/customization-group/~Common~vpn_act_step_ag")
+	newAgent := index("PATCH /mgmt/tm/apm/policy/agent/aaa-active-directory/~Common~vpn_act_step_ag")
+	assert.Less(t, detach, oldAgent)
+	assert.Less(t, oldAgent, oldGroup)
+	assert.Less(t, oldGroup, newAgent)
+	assert.NotContains(t, requests, "DELETE /mgmt/tm/apm/policy/policy-item/~Common~vpn_act_step")
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBigipApmAccessProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipApmAccessProfileCreate,
+		ReadContext:   resourceBigipApmAccessProfileRead,
+		UpdateContext: resourceBigipApmAccessProfileUpdate,
+		DeleteContext: resourceBigipApmAccessProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Access Profile",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/Common/access",
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Parent access profile the settings are inherited from",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "ltm-apm", "ssl-vpn", "portal", "rdg-rap", "swg-explicit", "swg-transparent", "system-authentication", "identity-service", "modern", "api-protection"}, false),
+				Description:  "Type of the access profile, which limits the agents its access policy can use",
+			},
+			"access_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Access policy run for new sessions, usually the name of a `bigip_apm_access_policy`",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined description",
+			},
+			"accept_languages": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Languages the logon pages and messages are offered in, e.g. `en`, `de`",
+			},
+			"default_language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Language used when the browser asks for none of `accept_languages`",
+			},
+			"access_policy_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds a user has to complete the access policy",
+			},
+			"inactivity_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds of inactivity after which a session is closed, 0 disables the timeout",
+			},
+			"max_session_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum lifetime of a session in seconds, 0 disables the timeout",
+			},
+			"max_concurrent_sessions": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of concurrent sessions, 0 means unlimited",
+			},
+			"max_concurrent_users": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of concurrent users, 0 means unlimited",
+			},
+			"restrict_to_single_client_ip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+				Description:  "Bind a session to the client address it was started from",
+			},
+			"secure_cookie": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+				Description:  "Set the secure attribute on the session cookie",
+			},
+			"httponly_cookie": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+				Description:  "Set the HttpOnly attribute on the session cookie",
+			},
+			"persistent_cookie": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+				Description:  "Keep the session cookie across browser restarts",
+			},
+			"domain_cookie": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Domain the session cookie is set for, used for single domain SSO",
+			},
+			"log_settings": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "APM log settings used by the profile",
+			},
+		},
+	}
+}
+
+func resourceBigipApmAccessProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Access Profile:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "apm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getApmAccessProfileConfig(d)
+	config.Name = name
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Type = d.Get("type").(string)
+	err := client.CreateAccessProfile(config)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Access Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := applyApmAccessProfile(client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmAccessProfileRead(ctx, d, meta)
+}
+
+func resourceBigipApmAccessProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Access Profile " + name)
+
+	profile, err := client.GetAccessProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Access Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if profile == nil {
+		log.Printf("[WARN] Access Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", profile.FullPath)
+	_ = d.Set("defaults_from", profile.DefaultsFrom)
+	_ = d.Set("type", profile.Type)
+	_ = d.Set("access_policy", profile.AccessPolicy)
+	_ = d.Set("description", profile.Description)
+	_ = d.Set("accept_languages", profile.AcceptLanguages)
+	_ = d.Set("default_language", profile.DefaultLanguage)
+	_ = d.Set("access_policy_timeout", profile.AccessPolicyTimeout)
+	_ = d.Set("inactivity_timeout", profile.InactivityTimeout)
+	_ = d.Set("max_session_timeout", profile.MaxSessionTimeout)
+	_ = d.Set("max_concurrent_sessions", profile.MaxConcurrentSessions)
+	_ = d.Set("max_concurrent_users", profile.MaxConcurrentUsers)
+	_ = d.Set("restrict_to_single_client_ip", profile.RestrictToSingleClientIP)
+	_ = d.Set("secure_cookie", profile.SecureCookie)
+	_ = d.Set("httponly_cookie", profile.HTTPOnlyCookie)
+	_ = d.Set("persistent_cookie", profile.PersistentCookie)
+	_ = d.Set("domain_cookie", profile.DomainCookie)
+	_ = d.Set("log_settings", profile.LogSettings)
+	return nil
+}
+
+func resourceBigipApmAccessProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Access Profile:%+v ", name)
+
+	err := client.ModifyAccessProfile(name, getApmAccessProfileConfig(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Access Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if err := applyApmAccessProfile(client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmAccessProfileRead(ctx, d, meta)
+}
+
+func resourceBigipApmAccessProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Access Profile " + name)
+
+	err := client.DeleteAccessProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Access Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getApmAccessProfileConfig(d *schema.ResourceData) *bigip.AccessProfile {
+	return &bigip.AccessProfile{
+		AccessPolicy:             d.Get("access_policy").(string),
+		Description:              d.Get("description").(string),
+		AcceptLanguages:          setToStringSlice(d.Get("accept_languages").(*schema.Set)),
+		DefaultLanguage:          d.Get("default_language").(string),
+		AccessPolicyTimeout:      d.Get("access_policy_timeout").(int),
+		InactivityTimeout:        d.Get("inactivity_timeout").(int),
+		MaxSessionTimeout:        d.Get("max_session_timeout").(int),
+		MaxConcurrentSessions:    d.Get("max_concurrent_sessions").(int),
+		MaxConcurrentUsers:       d.Get("max_concurrent_users").(int),
+		RestrictToSingleClientIP: d.Get("restrict_to_single_client_ip").(string),
+		SecureCookie:             d.Get("secure_cookie").(string),
+		HTTPOnlyCookie:           d.Get("httponly_cookie").(string),
+		PersistentCookie:         d.Get("persistent_cookie").(string),
+		DomainCookie:             d.Get("domain_cookie").(string),
+		LogSettings:              setToStringSlice(d.Get("log_settings").(*schema.Set)),
+	}
+}
+
+// applyApmAccessProfile activates the current access policy of the profile,
+// the same as "Apply Access Policy" in the GUI. Changes to a profile or its
+// policy are not used by new sessions until this is done.
+func applyApmAccessProfile(client *bigip.BigIP, name string) error {
+	log.Printf("[INFO] Applying Access Policy of Access Profile %s", name)
+	err := client.ModifyAccessProfile(name, &bigip.AccessProfile{GenerationAction: "increment"})
+	if err != nil {
+		return fmt.Errorf("error applying access policy of access profile %s: %v", name, err)
+	}
+	return nil
+}
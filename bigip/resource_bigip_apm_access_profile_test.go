@@ -0,0 +1,94 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestApmAccessProfileName = "/Common/test-access-profile"
+
+func TestAccBigipApmAccessProfileCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApmAccessProfilesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testApmAccessProfileResource(300),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApmAccessProfileExists(TestApmAccessProfileName),
+					resource.TestCheckResourceAttr("bigip_apm_access_profile.test-profile", "name", TestApmAccessProfileName),
+					resource.TestCheckResourceAttr("bigip_apm_access_profile.test-profile", "access_policy", TestApmAccessPolicyName),
+					resource.TestCheckResourceAttr("bigip_apm_access_profile.test-profile", "inactivity_timeout", "300"),
+				),
+			},
+			{
+				Config: testApmAccessProfileResource(900),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_access_profile.test-profile", "inactivity_timeout", "900"),
+				),
+			},
+			{
+				ResourceName:      "bigip_apm_access_profile.test-profile",
+				ImportStateId:     TestApmAccessProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckApmAccessProfileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		profile, err := client.GetAccessProfile(name)
+		if err != nil {
+			return err
+		}
+		if profile == nil {
+			return fmt.Errorf("Access Profile %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckApmAccessProfilesDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_apm_access_profile" {
+			continue
+		}
+		profile, err := client.GetAccessProfile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if profile != nil {
+			return fmt.Errorf("Access Profile %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return testCheckApmAccessPoliciesDestroyed(s)
+}
+
+func testApmAccessProfileResource(inactivityTimeout int) string {
+	return testApmAccessPolicyResource(false) + fmt.Sprintf(`
+resource "bigip_apm_access_profile" "test-profile" {
+  name               = "%s"
+  type               = "ltm-apm"
+  access_policy      = bigip_apm_access_policy.test-policy.name
+  accept_languages   = ["en"]
+  inactivity_timeout = %d
+  secure_cookie      = "true"
+}
+`, TestApmAccessProfileName, inactivityTimeout)
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriApmWebtop = "apm/resource/webtop"
+
+// go-bigip's BooledString never sets its value when unmarshalling, so the
+// webtop is read into this struct instead of bigip.WebtopRead.
+type apmWebtop struct {
+	FullPath           string `json:"fullPath,omitempty"`
+	Description        string `json:"description,omitempty"`
+	LinkType           string `json:"linkType,omitempty"`
+	CustomizationGroup string `json:"customizationGroup,omitempty"`
+	WebtopType         string `json:"webtopType,omitempty"`
+	CustomizationType  string `json:"customizationType,omitempty"`
+	LocationSpecific   string `json:"locationSpecific,omitempty"`
+	MinimizeToTray     string `json:"minimizeToTray,omitempty"`
+	ShowSearch         string `json:"showSearch,omitempty"`
+	WarningOnClose     string `json:"warningOnClose,omitempty"`
+	UrlEntryField      string `json:"urlEntryField,omitempty"`
+	ResourceSearch     string `json:"resourceSearch,omitempty"`
+	InitialState       string `json:"initialState,omitempty"`
+}
+
+func resourceBigipApmWebtop() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipApmWebtopCreate,
+		ReadContext:   resourceBigipApmWebtopRead,
+		UpdateContext: resourceBigipApmWebtopUpdate,
+		DeleteContext: resourceBigipApmWebtopDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the Webtop",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(bigip.WebtopTypeFull), string(bigip.WebtopTypePortal), string(bigip.WebtopTypeNetwork)}, false),
+				Description:  "Type of the webtop",
+			},
+			"link_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"uri", "application-uri", "hosted-content"}, false),
+				Description:  "What a portal access webtop opens after logon",
+			},
+			"customization_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Customization group of the webtop, BIG-IP creates one when not set",
+			},
+			"customization_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(bigip.CustomizationTypeModern), string(bigip.CustomizationTypeStandard)}, false),
+				Description:  "Look of the webtop",
+			},
+			"location_specific": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Webtop is specific to the location of the user",
+			},
+			"minimize_to_tray": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Minimize the webtop to the system tray when a network access resource starts",
+			},
+			"show_search": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Show a web search box on the webtop",
+			},
+			"warning_on_close": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Warn the user that closing the webtop ends the session",
+			},
+			"url_entry_field": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Show a field to open any URL through the webtop",
+			},
+			"resource_search": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Show a search box filtering the resources of the webtop",
+			},
+			"initial_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(bigip.InitialStateCollapsed),
+				ValidateFunc: validation.StringInSlice([]string{string(bigip.InitialStateCollapsed), string(bigip.InitialStateExpanded)}, false),
+				Description:  "Initial state of the webtop sections",
+			},
+		},
+	}
+}
+
+func resourceBigipApmWebtopCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Webtop:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "apm"); err != nil {
+		return diag.FromErr(err)
+	}
+	partition, base := splitApmFullPath(name)
+	config := bigip.Webtop{
+		Name:         base,
+		Partition:    partition,
+		WebtopConfig: getApmWebtopConfig(d),
+	}
+	err := client.CreateWebtop(ctx, config)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Webtop (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipApmWebtopRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Webtop " + name)
+
+	var webtop apmWebtop
+	found, err := getTmEntity(client, &webtop, uriApmWebtop, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Webtop (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Webtop (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", webtop.FullPath)
+	_ = d.Set("description", webtop.Description)
+	_ = d.Set("type", webtop.WebtopType)
+	_ = d.Set("link_type", webtop.LinkType)
+	_ = d.Set("customization_group", webtop.CustomizationGroup)
+	_ = d.Set("customization_type", webtop.CustomizationType)
+	_ = d.Set("location_specific", webtop.LocationSpecific == "true")
+	_ = d.Set("minimize_to_tray", webtop.MinimizeToTray == "true")
+	_ = d.Set("show_search", webtop.ShowSearch == "true")
+	_ = d.Set("warning_on_close", webtop.WarningOnClose == "true")
+	_ = d.Set("url_entry_field", webtop.UrlEntryField == "true")
+	_ = d.Set("resource_search", webtop.ResourceSearch == "true")
+	_ = d.Set("initial_state", webtop.InitialState)
+	return nil
+}
+
+func resourceBigipApmWebtopUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Webtop:%+v ", name)
+
+	err := client.ModifyWebtop(ctx, name, getApmWebtopConfig(d))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Webtop (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmWebtopRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Webtop " + name)
+
+	err := client.DeleteWebtop(ctx, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Webtop (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getApmWebtopConfig(d *schema.ResourceData) bigip.WebtopConfig {
+	return bigip.WebtopConfig{
+		Description:        d.Get("description").(string),
+		LinkType:           bigip.LinkType(d.Get("link_type").(string)),
+		CustomizationGroup: d.Get("customization_group").(string),
+		Type:               bigip.WebtopType(d.Get("type").(string)),
+		CustomizationType:  bigip.CustomizationType(d.Get("customization_type").(string)),
+		LocationSpecific:   bigip.BooledString(d.Get("location_specific").(bool)),
+		MinimizeToTray:     bigip.BooledString(d.Get("minimize_to_tray").(bool)),
+		ShowSearch:         bigip.BooledString(d.Get("show_search").(bool)),
+		WarningOnClose:     bigip.BooledString(d.Get("warning_on_close").(bool)),
+		UrlEntryField:      bigip.BooledString(d.Get("url_entry_field").(bool)),
+		ResourceSearch:     bigip.BooledString(d.Get("resource_search").(bool)),
+		InitialState:       bigip.InitialState(d.Get("initial_state").(string)),
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriApmWebtopLink = "apm/resource/webtop-link"
+
+type apmWebtopLink struct {
+	Name               string `json:"name,omitempty"`
+	Partition          string `json:"partition,omitempty"`
+	FullPath           string `json:"fullPath,omitempty"`
+	Description        string `json:"description"`
+	Caption            string `json:"caption,omitempty"`
+	LinkType           string `json:"linkType,omitempty"`
+	ApplicationUri     string `json:"applicationUri,omitempty"`
+	HostedContent      string `json:"hostedContent,omitempty"`
+	CustomizationGroup string `json:"customizationGroup,omitempty"`
+}
+
+func resourceBigipApmWebtopLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipApmWebtopLinkCreate,
+		ReadContext:   resourceBigipApmWebtopLinkRead,
+		UpdateContext: resourceBigipApmWebtopLinkUpdate,
+		DeleteContext: resourceBigipApmWebtopLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the Webtop Link",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"caption": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Text shown for the link on the webtop, defaults to the name",
+			},
+			"link_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "uri",
+				ValidateFunc: validation.StringInSlice([]string{"uri", "hosted-content"}, false),
+				Description:  "Whether the link opens `application_uri` or a file in `hosted_content`",
+			},
+			"application_uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URI opened by the link, for `uri` links",
+			},
+			"hosted_content": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hosted content file opened by the link, for `hosted-content` links",
+			},
+			"customization_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Customization group of the link, BIG-IP creates one when not set",
+			},
+		},
+	}
+}
+
+func resourceBigipApmWebtopLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Webtop Link:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "apm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getApmWebtopLinkConfig(d)
+	config.Partition, config.Name = splitApmFullPath(name)
+	err := createTmEntity(client, config, uriApmWebtopLink)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Webtop Link (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipApmWebtopLinkRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Webtop Link " + name)
+
+	var link apmWebtopLink
+	found, err := getTmEntity(client, &link, uriApmWebtopLink, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Webtop Link (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Webtop Link (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", link.FullPath)
+	_ = d.Set("description", link.Description)
+	_ = d.Set("caption", link.Caption)
+	_ = d.Set("link_type", link.LinkType)
+	_ = d.Set("application_uri", link.ApplicationUri)
+	_ = d.Set("hosted_content", link.HostedContent)
+	_ = d.Set("customization_group", link.CustomizationGroup)
+	return nil
+}
+
+func resourceBigipApmWebtopLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Webtop Link:%+v ", name)
+
+	err := patchTmEntity(client, getApmWebtopLinkConfig(d), uriApmWebtopLink, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Webtop Link (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmWebtopLinkRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Webtop Link " + name)
+
+	err := deleteTmEntity(client, uriApmWebtopLink, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Webtop Link (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getApmWebtopLinkConfig(d *schema.ResourceData) *apmWebtopLink {
+	return &apmWebtopLink{
+		Description:        d.Get("description").(string),
+		Caption:            d.Get("caption").(string),
+		LinkType:           d.Get("link_type").(string),
+		ApplicationUri:     d.Get("application_uri").(string),
+		HostedContent:      d.Get("hosted_content").(string),
+		CustomizationGroup: d.Get("customization_group").(string),
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestApmWebtopLinkName = "/Common/test-webtop-link"
+
+func TestAccBigipApmWebtopLinkCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApmObjectsDestroyed("bigip_apm_webtop_link", uriApmWebtopLink),
+		Steps: []resource.TestStep{
+			{
+				Config: testApmWebtopLinkResource("https://wiki.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApmObjectExists(uriApmWebtopLink, TestApmWebtopLinkName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop_link.test-link", "name", TestApmWebtopLinkName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop_link.test-link", "application_uri", "https://wiki.example.com"),
+				),
+			},
+			{
+				Config: testApmWebtopLinkResource("https://docs.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_webtop_link.test-link", "application_uri", "https://docs.example.com"),
+				),
+			},
+			{
+				ResourceName:      "bigip_apm_webtop_link.test-link",
+				ImportStateId:     TestApmWebtopLinkName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckApmObjectsDestroyed(resourceType, uri string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			var entity map[string]interface{}
+			found, err := getTmEntity(client, &entity, uri, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed ", uri, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testApmWebtopLinkResource(uri string) string {
+	return fmt.Sprintf(`
+resource "bigip_apm_webtop_link" "test-link" {
+  name            = "%s"
+  caption         = "Wiki"
+  application_uri = "%s"
+}
+`, TestApmWebtopLinkName, uri)
+}
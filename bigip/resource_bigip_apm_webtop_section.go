@@ -0,0 +1,185 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriApmWebtopSection = "apm/resource/webtop-section"
+
+type apmWebtopSection struct {
+	Name               string                 `json:"name,omitempty"`
+	Partition          string                 `json:"partition,omitempty"`
+	FullPath           string                 `json:"fullPath,omitempty"`
+	Description        string                 `json:"description"`
+	Caption            string                 `json:"caption,omitempty"`
+	DisplayOrder       int                    `json:"displayOrder"`
+	InitialState       string                 `json:"initialState,omitempty"`
+	WebtopSectionItems []apmWebtopSectionItem `json:"webtopSectionItems"`
+	CustomizationGroup string                 `json:"customizationGroup,omitempty"`
+}
+
+type apmWebtopSectionItem struct {
+	Name string `json:"name"`
+}
+
+func resourceBigipApmWebtopSection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipApmWebtopSectionCreate,
+		ReadContext:   resourceBigipApmWebtopSectionRead,
+		UpdateContext: resourceBigipApmWebtopSectionUpdate,
+		DeleteContext: resourceBigipApmWebtopSectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the Webtop Section",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"caption": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Title of the section on the webtop, defaults to the name",
+			},
+			"display_order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Position of the section on the webtop, lower values are shown first",
+			},
+			"initial_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "expanded",
+				ValidateFunc: validation.StringInSlice([]string{"collapsed", "expanded"}, false),
+				Description:  "Whether the section is shown collapsed or expanded",
+			},
+			"links": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Webtop links shown in the section, in order",
+			},
+			"customization_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Customization group of the section, BIG-IP creates one when not set",
+			},
+		},
+	}
+}
+
+func resourceBigipApmWebtopSectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Webtop Section:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "apm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getApmWebtopSectionConfig(d)
+	config.Partition, config.Name = splitApmFullPath(name)
+	err := createTmEntity(client, config, uriApmWebtopSection)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Webtop Section (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipApmWebtopSectionRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopSectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Webtop Section " + name)
+
+	var section apmWebtopSection
+	found, err := getTmEntity(client, &section, uriApmWebtopSection, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Webtop Section (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Webtop Section (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", section.FullPath)
+	_ = d.Set("description", section.Description)
+	_ = d.Set("caption", section.Caption)
+	_ = d.Set("display_order", section.DisplayOrder)
+	_ = d.Set("initial_state", section.InitialState)
+	_ = d.Set("customization_group", section.CustomizationGroup)
+	var links []string
+	for _, item := range section.WebtopSectionItems {
+		links = append(links, item.Name)
+	}
+	if err := d.Set("links", links); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving links to state for Webtop Section (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipApmWebtopSectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Webtop Section:%+v ", name)
+
+	err := patchTmEntity(client, getApmWebtopSectionConfig(d), uriApmWebtopSection, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Webtop Section (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipApmWebtopSectionRead(ctx, d, meta)
+}
+
+func resourceBigipApmWebtopSectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Webtop Section " + name)
+
+	err := deleteTmEntity(client, uriApmWebtopSection, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Webtop Section (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getApmWebtopSectionConfig(d *schema.ResourceData) *apmWebtopSection {
+	config := &apmWebtopSection{
+		Description:        d.Get("description").(string),
+		Caption:            d.Get("caption").(string),
+		DisplayOrder:       d.Get("display_order").(int),
+		InitialState:       d.Get("initial_state").(string),
+		WebtopSectionItems: []apmWebtopSectionItem{},
+		CustomizationGroup: d.Get("customization_group").(string),
+	}
+	for _, link := range listToStringSlice(d.Get("links").([]interface{})) {
+		config.WebtopSectionItems = append(config.WebtopSectionItems, apmWebtopSectionItem{Name: link})
+	}
+	return config
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestApmWebtopSectionName = "/Common/test-webtop-section"
+
+func TestAccBigipApmWebtopSectionCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApmObjectsDestroyed("bigip_apm_webtop_section", uriApmWebtopSection),
+		Steps: []resource.TestStep{
+			{
+				Config: testApmWebtopSectionResource("expanded"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApmObjectExists(uriApmWebtopSection, TestApmWebtopSectionName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop_section.test-section", "name", TestApmWebtopSectionName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop_section.test-section", "links.#", "1"),
+					resource.TestCheckResourceAttr("bigip_apm_webtop_section.test-section", "links.0", TestApmWebtopLinkName),
+				),
+			},
+			{
+				Config: testApmWebtopSectionResource("collapsed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_webtop_section.test-section", "initial_state", "collapsed"),
+				),
+			},
+			{
+				ResourceName:      "bigip_apm_webtop_section.test-section",
+				ImportStateId:     TestApmWebtopSectionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testApmWebtopSectionResource(initialState string) string {
+	return testApmWebtopLinkResource("https://wiki.example.com") + fmt.Sprintf(`
+resource "bigip_apm_webtop_section" "test-section" {
+  name          = "%s"
+  caption       = "Internal"
+  initial_state = "%s"
+  links         = [bigip_apm_webtop_link.test-link.name]
+}
+`, TestApmWebtopSectionName, initialState)
+}
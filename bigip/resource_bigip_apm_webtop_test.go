@@ -0,0 +1,60 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestApmWebtopName = "/Common/test-webtop"
+
+func TestAccBigipApmWebtopCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckApmObjectsDestroyed("bigip_apm_webtop", uriApmWebtop),
+		Steps: []resource.TestStep{
+			{
+				Config: testApmWebtopResource(true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckApmObjectExists(uriApmWebtop, TestApmWebtopName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop.test-webtop", "name", TestApmWebtopName),
+					resource.TestCheckResourceAttr("bigip_apm_webtop.test-webtop", "type", "full"),
+					resource.TestCheckResourceAttr("bigip_apm_webtop.test-webtop", "minimize_to_tray", "true"),
+				),
+			},
+			{
+				Config: testApmWebtopResource(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_apm_webtop.test-webtop", "minimize_to_tray", "false"),
+				),
+			},
+			{
+				ResourceName:      "bigip_apm_webtop.test-webtop",
+				ImportStateId:     TestApmWebtopName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testApmWebtopResource(minimizeToTray bool) string {
+	return fmt.Sprintf(`
+resource "bigip_apm_webtop" "test-webtop" {
+  name               = "%s"
+  type               = "full"
+  customization_type = "Modern"
+  minimize_to_tray   = %t
+  show_search        = true
+}
+`, TestApmWebtopName, minimizeToTray)
+}
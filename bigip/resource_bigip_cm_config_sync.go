@@ -0,0 +1,225 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	uriCm           = "cm"
+	uriCmSyncStatus = "cm/sync-status"
+)
+
+type tmStatsEntry struct {
+	Description string        `json:"description"`
+	NestedStats tmNestedStats `json:"nestedStats"`
+}
+
+type tmNestedStats struct {
+	Entries map[string]tmStatsEntry `json:"entries"`
+}
+
+type cmSyncStatus struct {
+	Color   string
+	Mode    string
+	Status  string
+	Summary string
+	Details []string
+}
+
+func resourceBigipCmConfigSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipCmConfigSyncCreate,
+		ReadContext:   resourceBigipCmConfigSyncRead,
+		UpdateContext: resourceBigipCmConfigSyncUpdate,
+		DeleteContext: resourceBigipCmConfigSyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"device_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Device group the configuration of this device is synced to",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, the configuration is synced again whenever one of them changes",
+			},
+			"force_full_load_push": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Push the full configuration instead of the incremental changes",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sync status of the device group",
+			},
+		},
+	}
+}
+
+func resourceBigipCmConfigSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	group := d.Get("device_group").(string)
+	if err := runCmConfigSync(ctx, meta.(*bigip.BigIP), d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(group)
+	return resourceBigipCmConfigSyncRead(ctx, d, meta)
+}
+
+func resourceBigipCmConfigSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	group := d.Id()
+	log.Println("[INFO] Fetching Sync Status of Device Group " + group)
+
+	status, err := getCmSyncStatus(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Sync Status (%s) (%v) ", group, err)
+		return diag.FromErr(err)
+	}
+	groupStatus, _ := status.deviceGroupStatus(group)
+	_ = d.Set("device_group", group)
+	_ = d.Set("status", groupStatus)
+	return nil
+}
+
+func resourceBigipCmConfigSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("triggers", "force_full_load_push") {
+		if err := runCmConfigSync(ctx, meta.(*bigip.BigIP), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipCmConfigSyncRead(ctx, d, meta)
+}
+
+// Destroying the resource does not change the device group, it only stops
+// syncing it.
+func resourceBigipCmConfigSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Println("[INFO] Removing Config Sync of Device Group " + d.Id())
+	d.SetId("")
+	return nil
+}
+
+func runCmConfigSync(ctx context.Context, client *bigip.BigIP, d *schema.ResourceData) error {
+	group := d.Get("device_group").(string)
+	log.Printf("[INFO] Syncing configuration to Device Group:%+v ", group)
+
+	args := "config-sync to-group " + group
+	if d.Get("force_full_load_push").(bool) {
+		args = "config-sync force-full-load-push to-group " + group
+	}
+	err := createTmEntity(client, &bigip.BigipCommand{Command: "run", UtilCmdArgs: args}, uriCm)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Sync Device Group (%s) (%v) ", group, err)
+		return err
+	}
+	return waitCmConfigSync(ctx, client, group)
+}
+
+// waitCmConfigSync waits until the device group is in sync. A sync failure or
+// timeout is reported with the per-device details of the sync status.
+func waitCmConfigSync(ctx context.Context, client *bigip.BigIP, group string) error {
+	var status *cmSyncStatus
+	var groupStatus string
+	err := pollUntil(ctx, func() (bool, error) {
+		var err error
+		if status, err = getCmSyncStatus(client); err != nil {
+			return false, err
+		}
+		var ok bool
+		if groupStatus, ok = status.deviceGroupStatus(group); !ok {
+			return false, fmt.Errorf("device group %s is not in the sync status of this device", group)
+		}
+		switch groupStatus {
+		case "In Sync":
+			return true, nil
+		case "Sync Failure":
+			return false, fmt.Errorf("config-sync to device group %s failed:\n%s", group, strings.Join(status.Details, "\n"))
+		}
+		log.Printf("[DEBUG] Device group %s is %s, waiting", group, groupStatus)
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for config-sync to device group %s, status is %s:\n%s", group, groupStatus, strings.Join(status.Details, "\n"))
+	}
+	return err
+}
+
+func getCmSyncStatus(client *bigip.BigIP) (*cmSyncStatus, error) {
+	var stats tmNestedStats
+	if _, err := getTmEntity(client, &stats, uriCmSyncStatus); err != nil {
+		return nil, err
+	}
+	return parseCmSyncStatus(&stats), nil
+}
+
+// The sync status is a single stats entry, its details are a nested list of
+// lines such as "bigip2.example.com: connected" or
+// "failover-group (In Sync): All devices in the device group are in sync".
+func parseCmSyncStatus(stats *tmNestedStats) *cmSyncStatus {
+	status := &cmSyncStatus{}
+	for _, entry := range stats.Entries {
+		entries := entry.NestedStats.Entries
+		status.Color = entries["color"].Description
+		status.Mode = entries["mode"].Description
+		status.Status = entries["status"].Description
+		status.Summary = entries["summary"].Description
+		for key, details := range entries {
+			if !strings.HasSuffix(key, "/details") {
+				continue
+			}
+			var keys []string
+			for k := range details.NestedStats.Entries {
+				keys = append(keys, k)
+			}
+			sort.Slice(keys, func(i, j int) bool {
+				return statsIndex(keys[i]) < statsIndex(keys[j])
+			})
+			for _, k := range keys {
+				status.Details = append(status.Details, details.NestedStats.Entries[k].NestedStats.Entries["details"].Description)
+			}
+		}
+	}
+	return status
+}
+
+func statsIndex(key string) int {
+	i, _ := strconv.Atoi(key[strings.LastIndex(key, "/")+1:])
+	return i
+}
+
+// deviceGroupStatus returns the status of a device group from the details,
+// device groups can be given with or without partition.
+func (s *cmSyncStatus) deviceGroupStatus(group string) (string, bool) {
+	name := group[strings.LastIndex(group, "/")+1:]
+	for _, line := range s.Details {
+		if rest, ok := strings.CutPrefix(line, name+" ("); ok {
+			if status, _, ok := strings.Cut(rest, ")"); ok {
+				return status, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The config-sync test needs a device in a manual-sync device group, set
+// through BIGIP_SYNC_GROUP.
+func TestAccBigipCmConfigSyncCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+			if os.Getenv("BIGIP_SYNC_GROUP") == "" {
+				t.Skip("BIGIP_SYNC_GROUP is not set, skipping config-sync test")
+			}
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCmConfigSyncResource("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_config_sync.test-sync", "status", "In Sync"),
+				),
+			},
+			{
+				Config: testCmConfigSyncResource("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_config_sync.test-sync", "status", "In Sync"),
+					resource.TestCheckResourceAttr("data.bigip_cm_sync_status.test-status", "device_group_status", "In Sync"),
+				),
+			},
+		},
+	})
+}
+
+func testCmConfigSyncResource(description string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_node" "test-sync-node" {
+  name        = "/Common/test-sync-node"
+  address     = "192.0.2.77"
+  description = "%[1]s"
+}
+
+resource "bigip_cm_config_sync" "test-sync" {
+  device_group = "%[2]s"
+  triggers = {
+    node = bigip_ltm_node.test-sync-node.description
+  }
+}
+
+data "bigip_cm_sync_status" "test-status" {
+  device_group = "%[2]s"
+  depends_on   = [bigip_cm_config_sync.test-sync]
+}
+`, description, os.Getenv("BIGIP_SYNC_GROUP"))
+}
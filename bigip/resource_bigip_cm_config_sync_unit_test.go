@@ -0,0 +1,107 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCmSyncStatusResponse(status, groupStatus string) string {
+	return fmt.Sprintf(`{"entries":{"https://localhost/mgmt/tm/cm/sync-status/0":{"nestedStats":{"entries":{
+"color":{"description":"blue"},
+"mode":{"description":"high-availability"},
+"status":{"description":"%[1]s"},
+"summary":{"description":"There is a possible change conflict"},
+"https://localhost/mgmt/tm/cm/syncStatus/0/details":{"nestedStats":{"entries":{
+"https://localhost/mgmt/tm/cm/syncStatus/0/details/10":{"nestedStats":{"entries":{"details":{"description":"failover-group (%[2]s): Sync to bigip2.example.com"}}}},
+"https://localhost/mgmt/tm/cm/syncStatus/0/details/2":{"nestedStats":{"entries":{"details":{"description":"bigip2.example.com: connected (for 3600 seconds)"}}}},
+"https://localhost/mgmt/tm/cm/syncStatus/0/details/1":{"nestedStats":{"entries":{"details":{"description":"bigip1.example.com: connected (for 3600 seconds)"}}}}
+}}}}}}}}`, status, groupStatus)
+}
+
+func TestParseCmSyncStatus(t *testing.T) {
+	var stats tmNestedStats
+	assert.NoError(t, json.Unmarshal([]byte(testCmSyncStatusResponse("Changes Pending", "Changes Pending")), &stats))
+	status := parseCmSyncStatus(&stats)
+	assert.Equal(t, "Changes Pending", status.Status)
+	assert.Equal(t, "blue", status.Color)
+	assert.Equal(t, "high-availability", status.Mode)
+	assert.Equal(t, []string{
+		"bigip1.example.com: connected (for 3600 seconds)",
+		"bigip2.example.com: connected (for 3600 seconds)",
+		"failover-group (Changes Pending): Sync to bigip2.example.com",
+	}, status.Details)
+
+	groupStatus, ok := status.deviceGroupStatus("/Common/failover-group")
+	assert.True(t, ok)
+	assert.Equal(t, "Changes Pending", groupStatus)
+	_, ok = status.deviceGroupStatus("other-group")
+	assert.False(t, ok)
+}
+
+func TestRunCmConfigSync(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	var command bigip.BigipCommand
+	polls := 0
+	groupStatus := []string{"Changes Pending", "Syncing", "In Sync"}
+	mux.HandleFunc("/mgmt/tm/cm", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&command)
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/cm/sync-status", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, testCmSyncStatusResponse("In Sync", groupStatus[polls]))
+		if polls < len(groupStatus)-1 {
+			polls++
+		}
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+	d := schema.TestResourceDataRaw(t, resourceBigipCmConfigSync().Schema, map[string]interface{}{
+		"device_group":         "failover-group",
+		"force_full_load_push": true,
+	})
+
+	assert.NoError(t, runCmConfigSync(context.Background(), client, d))
+	assert.Equal(t, "run", command.Command)
+	assert.Equal(t, "config-sync force-full-load-push to-group failover-group", command.UtilCmdArgs)
+	assert.Equal(t, 2, polls)
+
+	groupStatus = []string{"Sync Failure"}
+	polls = 0
+	err := waitCmConfigSync(context.Background(), client, "failover-group")
+	assert.EqualError(t, err, `config-sync to device group failover-group failed:
+bigip1.example.com: connected (for 3600 seconds)
+bigip2.example.com: connected (for 3600 seconds)
+failover-group (Sync Failure): Sync to bigip2.example.com`)
+
+	groupStatus = []string{"Changes Pending"}
+	polls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = waitCmConfigSync(ctx, client, "failover-group")
+	assert.ErrorContains(t, err, "timed out waiting for config-sync to device group failover-group, status is Changes Pending:")
+}
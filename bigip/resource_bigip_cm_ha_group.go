@@ -0,0 +1,221 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSysHaGroup = "sys/ha-group"
+
+type haGroupMember struct {
+	Name             string `json:"name"`
+	Attribute        string `json:"attribute,omitempty"`
+	Weight           int    `json:"weight"`
+	MinimumThreshold int    `json:"minimumThreshold"`
+}
+
+type haGroup struct {
+	Name        string          `json:"name,omitempty"`
+	FullPath    string          `json:"fullPath,omitempty"`
+	Description string          `json:"description"`
+	Enabled     bool            `json:"enabled,omitempty"`
+	Disabled    bool            `json:"disabled,omitempty"`
+	ActiveBonus int             `json:"activeBonus"`
+	Pools       []haGroupMember `json:"pools"`
+	Trunks      []haGroupMember `json:"trunks"`
+}
+
+func haGroupMemberSchema(desc string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: desc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the object",
+				},
+				"weight": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+					Description:  "Score the object adds when all of its members are up",
+				},
+				"threshold": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Minimum number of members that must be up, below it the object adds nothing to the score",
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipCmHaGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipCmHaGroupCreate,
+		ReadContext:   resourceBigipCmHaGroupRead,
+		UpdateContext: resourceBigipCmHaGroupUpdate,
+		DeleteContext: resourceBigipCmHaGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the HA Group",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Use the HA group score to choose the active device",
+			},
+			"active_bonus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(0, 100),
+				Description:  "Score added to the active device, to avoid failover on small score differences",
+			},
+			"pool":  haGroupMemberSchema("Pools whose available members add to the score"),
+			"trunk": haGroupMemberSchema("Trunks whose up interfaces add to the score"),
+		},
+	}
+}
+
+func resourceBigipCmHaGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating HA Group:%+v ", name)
+
+	config := getCmHaGroupConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriSysHaGroup)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create HA Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipCmHaGroupRead(ctx, d, meta)
+}
+
+func resourceBigipCmHaGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching HA Group " + name)
+
+	var group haGroup
+	found, err := getTmEntity(client, &group, uriSysHaGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve HA Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] HA Group (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", group.FullPath)
+	_ = d.Set("description", group.Description)
+	_ = d.Set("enabled", !group.Disabled)
+	_ = d.Set("active_bonus", group.ActiveBonus)
+	if err := d.Set("pool", flattenHaGroupMembers(group.Pools)); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving pool to state for HA Group (%s): %s", name, err))
+	}
+	if err := d.Set("trunk", flattenHaGroupMembers(group.Trunks)); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving trunk to state for HA Group (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipCmHaGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating HA Group:%+v ", name)
+
+	err := patchTmEntity(client, getCmHaGroupConfig(d), uriSysHaGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify HA Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipCmHaGroupRead(ctx, d, meta)
+}
+
+func resourceBigipCmHaGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting HA Group " + name)
+
+	err := deleteTmEntity(client, uriSysHaGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete HA Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getCmHaGroupConfig(d *schema.ResourceData) *haGroup {
+	config := &haGroup{
+		Description: d.Get("description").(string),
+		ActiveBonus: d.Get("active_bonus").(int),
+		Pools:       expandHaGroupMembers(d.Get("pool").(*schema.Set)),
+		Trunks:      expandHaGroupMembers(d.Get("trunk").(*schema.Set)),
+	}
+	if d.Get("enabled").(bool) {
+		config.Enabled = true
+	} else {
+		config.Disabled = true
+	}
+	return config
+}
+
+func expandHaGroupMembers(set *schema.Set) []haGroupMember {
+	members := []haGroupMember{}
+	for _, item := range set.List() {
+		m := item.(map[string]interface{})
+		members = append(members, haGroupMember{
+			Name:             m["name"].(string),
+			Attribute:        "percent-up-members",
+			Weight:           m["weight"].(int),
+			MinimumThreshold: m["threshold"].(int),
+		})
+	}
+	return members
+}
+
+func flattenHaGroupMembers(members []haGroupMember) []interface{} {
+	var result []interface{}
+	for _, m := range members {
+		result = append(result, map[string]interface{}{
+			"name":      m.Name,
+			"weight":    m.Weight,
+			"threshold": m.MinimumThreshold,
+		})
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestCmHaGroupName = "/Common/test-ha-group"
+
+func TestAccBigipCmHaGroupCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_cm_ha_group", uriSysHaGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: testCmHaGroupResource(10, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriSysHaGroup, TestCmHaGroupName),
+					resource.TestCheckResourceAttr("bigip_cm_ha_group.test-ha", "name", TestCmHaGroupName),
+					resource.TestCheckResourceAttr("bigip_cm_ha_group.test-ha", "active_bonus", "10"),
+					resource.TestCheckResourceAttr("bigip_cm_ha_group.test-ha", "pool.#", "1"),
+				),
+			},
+			{
+				Config: testCmHaGroupResource(50, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("bigip_cm_ha_group.test-ha", "pool.*", map[string]string{
+						"weight":    "50",
+						"threshold": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      "bigip_cm_ha_group.test-ha",
+				ImportStateId:     TestCmHaGroupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCmHaGroupResource(weight, threshold int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "test-ha-pool" {
+  name = "/Common/test-ha-pool"
+}
+
+resource "bigip_cm_ha_group" "test-ha" {
+  name         = "%s"
+  description  = "test HA group"
+  active_bonus = 10
+  pool {
+    name      = bigip_ltm_pool.test-ha-pool.name
+    weight    = %d
+    threshold = %d
+  }
+}
+`, TestCmHaGroupName, weight, threshold)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriCmTrafficGroup = "cm/traffic-group"
+
+type cmTrafficGroup struct {
+	Name                string   `json:"name,omitempty"`
+	FullPath            string   `json:"fullPath,omitempty"`
+	Description         string   `json:"description"`
+	FailoverMethod      string   `json:"failoverMethod,omitempty"`
+	HaOrder             []string `json:"haOrder"`
+	HaLoadFactor        int      `json:"haLoadFactor,omitempty"`
+	HaGroup             string   `json:"haGroup,omitempty"`
+	AutoFailbackEnabled string   `json:"autoFailbackEnabled,omitempty"`
+	AutoFailbackTime    int      `json:"autoFailbackTime"`
+	Mac                 string   `json:"mac,omitempty"`
+}
+
+func resourceBigipCmTrafficGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipCmTrafficGroupCreate,
+		ReadContext:   resourceBigipCmTrafficGroupRead,
+		UpdateContext: resourceBigipCmTrafficGroupUpdate,
+		DeleteContext: resourceBigipCmTrafficGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !d.NewValueKnown("ha_group") || !d.NewValueKnown("ha_order") {
+				return nil
+			}
+			return validateCmTrafficGroup(d.Get("failover_method").(string), d.Get("ha_group").(string),
+				listToStringSlice(d.Get("ha_order").([]interface{})), d.Get("auto_failback").(bool))
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Traffic Group",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"failover_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ha-order",
+				ValidateFunc: validation.StringInSlice([]string{"ha-order", "ha-score", "load-aware"}, false),
+				Description:  "How the next active device is chosen on failover",
+			},
+			"ha_order": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Devices the traffic group fails over to, in order of preference",
+			},
+			"ha_load_factor": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Relative load of the traffic group, used by the load-aware failover method",
+			},
+			"ha_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "HA group whose score picks the active device, used by the ha-score failover method",
+			},
+			"auto_failback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Fail back to the first device in ha_order once it is available again",
+			},
+			"auto_failback_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(0, 300),
+				Description:  "Seconds to wait before failing back",
+			},
+			"mac_masquerade": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9a-fA-F]{1,2}:){5}[0-9a-fA-F]{1,2}$`), "mac_masquerade must be a MAC address"),
+				Description:  "MAC address shared by the floating self IPs of the traffic group",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrafficGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Traffic Group:%+v ", name)
+
+	config := getCmTrafficGroupConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriCmTrafficGroup)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Traffic Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipCmTrafficGroupRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrafficGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Traffic Group " + name)
+
+	var group cmTrafficGroup
+	found, err := getTmEntity(client, &group, uriCmTrafficGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Traffic Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Traffic Group (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", group.FullPath)
+	_ = d.Set("description", group.Description)
+	_ = d.Set("failover_method", group.FailoverMethod)
+	_ = d.Set("ha_order", group.HaOrder)
+	_ = d.Set("ha_load_factor", group.HaLoadFactor)
+	_ = d.Set("ha_group", noneToEmpty(group.HaGroup))
+	_ = d.Set("auto_failback", group.AutoFailbackEnabled == "true")
+	_ = d.Set("auto_failback_time", group.AutoFailbackTime)
+	_ = d.Set("mac_masquerade", noneToEmpty(group.Mac))
+	return nil
+}
+
+func resourceBigipCmTrafficGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Traffic Group:%+v ", name)
+
+	err := patchTmEntity(client, getCmTrafficGroupConfig(d), uriCmTrafficGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Traffic Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipCmTrafficGroupRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrafficGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Traffic Group " + name)
+
+	err := deleteTmEntity(client, uriCmTrafficGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Traffic Group (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// Unset references are sent as none so that removing them clears them on the
+// device.
+func getCmTrafficGroupConfig(d *schema.ResourceData) *cmTrafficGroup {
+	config := &cmTrafficGroup{
+		Description:         d.Get("description").(string),
+		FailoverMethod:      d.Get("failover_method").(string),
+		HaOrder:             listToStringSlice(d.Get("ha_order").([]interface{})),
+		HaLoadFactor:        d.Get("ha_load_factor").(int),
+		HaGroup:             emptyToNone(d.Get("ha_group").(string)),
+		AutoFailbackEnabled: "false",
+		AutoFailbackTime:    d.Get("auto_failback_time").(int),
+		Mac:                 emptyToNone(d.Get("mac_masquerade").(string)),
+	}
+	if d.Get("auto_failback").(bool) {
+		config.AutoFailbackEnabled = "true"
+	}
+	return config
+}
+
+func validateCmTrafficGroup(failoverMethod, haGroup string, haOrder []string, autoFailback bool) error {
+	if haGroup != "" && failoverMethod != "ha-score" {
+		return fmt.Errorf("ha_group is only used with failover_method ha-score, not %s", failoverMethod)
+	}
+	if failoverMethod == "ha-score" && haGroup == "" {
+		return fmt.Errorf("failover_method ha-score requires ha_group")
+	}
+	if autoFailback && (failoverMethod != "ha-order" || len(haOrder) == 0) {
+		return fmt.Errorf("auto_failback requires failover_method ha-order and at least one device in ha_order")
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestCmTrafficGroupName = "/Common/test-traffic-group"
+
+func TestAccBigipCmTrafficGroupCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_cm_traffic_group", uriCmTrafficGroup),
+		Steps: []resource.TestStep{
+			{
+				Config: testCmTrafficGroupResource(`failover_method = "load-aware"
+  ha_load_factor  = 5`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriCmTrafficGroup, TestCmTrafficGroupName),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "name", TestCmTrafficGroupName),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "failover_method", "load-aware"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "ha_load_factor", "5"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "mac_masquerade", "02:01:d7:93:35:08"),
+				),
+			},
+			{
+				Config: testCmTrafficGroupResource(`failover_method = "ha-score"
+  ha_group        = bigip_cm_ha_group.test-tg-ha.name`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "failover_method", "ha-score"),
+					resource.TestCheckResourceAttr("bigip_cm_traffic_group.test-tg", "ha_group", "/Common/test-tg-ha"),
+				),
+			},
+			{
+				Config:      testCmTrafficGroupResource(`auto_failback = true`),
+				ExpectError: regexp.MustCompile("auto_failback requires failover_method ha-order and at least one device in ha_order"),
+			},
+			{
+				ResourceName:      "bigip_cm_traffic_group.test-tg",
+				ImportStateId:     TestCmTrafficGroupName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCmTrafficGroupResource(failover string) string {
+	return fmt.Sprintf(`
+resource "bigip_cm_ha_group" "test-tg-ha" {
+  name = "/Common/test-tg-ha"
+}
+
+resource "bigip_cm_traffic_group" "test-tg" {
+  name           = "%s"
+  description    = "test traffic group"
+  mac_masquerade = "02:01:d7:93:35:08"
+  %s
+}
+`, TestCmTrafficGroupName, failover)
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCmTrafficGroup(t *testing.T) {
+	assert.NoError(t, validateCmTrafficGroup("ha-order", "", nil, false))
+	assert.NoError(t, validateCmTrafficGroup("ha-order", "", []string{"/Common/bigip1.example.com"}, true))
+	assert.NoError(t, validateCmTrafficGroup("ha-score", "/Common/ha1", nil, false))
+	assert.NoError(t, validateCmTrafficGroup("load-aware", "", nil, false))
+
+	assert.EqualError(t, validateCmTrafficGroup("ha-order", "/Common/ha1", nil, false),
+		"ha_group is only used with failover_method ha-score, not ha-order")
+	assert.EqualError(t, validateCmTrafficGroup("ha-score", "", nil, false),
+		"failover_method ha-score requires ha_group")
+	assert.EqualError(t, validateCmTrafficGroup("ha-order", "", nil, true),
+		"auto_failback requires failover_method ha-order and at least one device in ha_order")
+	assert.EqualError(t, validateCmTrafficGroup("load-aware", "", []string{"/Common/bigip1.example.com"}, true),
+		"auto_failback requires failover_method ha-order and at least one device in ha_order")
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriCmDevice          = "cm/device"
+	uriCmTrustDomain     = "cm/trust-domain/Root"
+	uriCmAddToTrust      = "cm/add-to-trust"
+	uriCmRemoveFromTrust = "cm/remove-from-trust"
+)
+
+type cmTrustCommand struct {
+	Command    string `json:"command"`
+	Name       string `json:"name"`
+	CaDevice   bool   `json:"caDevice"`
+	Device     string `json:"device,omitempty"`
+	DeviceName string `json:"deviceName"`
+	Username   string `json:"username,omitempty"`
+	Password   string `json:"password,omitempty"`
+}
+
+type cmTrustDomain struct {
+	CaDevices    []string `json:"caDevices"`
+	TrustDevices []string `json:"trustDevices"`
+}
+
+type cmDeviceStatus struct {
+	Name          string `json:"name"`
+	ManagementIp  string `json:"managementIp"`
+	FailoverState string `json:"failoverState"`
+}
+
+func resourceBigipCmTrust() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipCmTrustCreate,
+		ReadContext:   resourceBigipCmTrustRead,
+		UpdateContext: resourceBigipCmTrustUpdate,
+		DeleteContext: resourceBigipCmTrustDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"device_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the peer device, as in its bigip_cm_device, usually its host name",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Management address of the peer device",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Administrator on the peer device, only used to add it to the trust",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the administrator on the peer device",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "peer",
+				ValidateFunc: validation.StringInSlice([]string{"peer", "subordinate"}, false),
+				Description:  "Add the device as a peer certificate authority or as a subordinate",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Failover state of the peer as seen by this device, offline when the trust is broken",
+			},
+		},
+	}
+}
+
+func resourceBigipCmTrustCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("device_name").(string)
+	log.Printf("[INFO] Adding Device to Trust:%+v ", name)
+
+	command := &cmTrustCommand{
+		Command:    "run",
+		Name:       "Root",
+		CaDevice:   d.Get("type").(string) == "peer",
+		Device:     d.Get("address").(string),
+		DeviceName: name,
+		Username:   d.Get("username").(string),
+		Password:   d.Get("password").(string),
+	}
+	err := createTmEntity(client, command, uriCmAddToTrust)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Add Device to Trust (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := waitCmTrustDevice(ctx, client, name); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipCmTrustRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrustRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Trust of Device " + name)
+
+	trustType, device, err := getCmTrustDevice(client, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Trust of Device (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if trustType == "" {
+		log.Printf("[WARN] Device (%s) not in trust, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("device_name", name)
+	_ = d.Set("type", trustType)
+	_ = d.Set("status", device.FailoverState)
+	if _, ok := d.GetOk("address"); !ok {
+		_ = d.Set("address", device.ManagementIp)
+	}
+	return nil
+}
+
+// Only the credentials can change in place, they are not used
+// once the device is in the trust.
+func resourceBigipCmTrustUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceBigipCmTrustRead(ctx, d, meta)
+}
+
+func resourceBigipCmTrustDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Removing Device from Trust " + name)
+
+	err := createTmEntity(client, &cmTrustCommand{Command: "run", Name: "Root", DeviceName: name}, uriCmRemoveFromTrust)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Remove Device from Trust (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// getCmTrustDevice returns peer or subordinate for a device in the trust
+// domain, or an empty type when it is not in the trust.
+func getCmTrustDevice(client *bigip.BigIP, name string) (string, *cmDeviceStatus, error) {
+	var domain cmTrustDomain
+	if _, err := getTmEntity(client, &domain, uriCmTrustDomain); err != nil {
+		return "", nil, err
+	}
+	fullPath := "/Common/" + name
+	trustType := ""
+	switch {
+	case contains(domain.CaDevices, fullPath):
+		trustType = "peer"
+	case contains(domain.TrustDevices, fullPath):
+		trustType = "subordinate"
+	default:
+		return "", nil, nil
+	}
+	var device cmDeviceStatus
+	found, err := getTmEntity(client, &device, uriCmDevice, fullPath)
+	if err != nil {
+		return "", nil, err
+	}
+	if !found {
+		return "", nil, nil
+	}
+	return trustType, &device, nil
+}
+
+func waitCmTrustDevice(ctx context.Context, client *bigip.BigIP, name string) error {
+	err := pollUntil(ctx, func() (bool, error) {
+		trustType, _, err := getCmTrustDevice(client, name)
+		if err != nil || trustType != "" {
+			return err == nil, err
+		}
+		log.Printf("[DEBUG] Device %s is not in the trust yet, waiting", name)
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for device %s to join the trust", name)
+	}
+	return err
+}
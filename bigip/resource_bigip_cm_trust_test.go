@@ -0,0 +1,82 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The trust tests need a second BIG-IP, set through BIGIP_PEER_NAME,
+// BIGIP_PEER_ADDRESS, BIGIP_PEER_USER and BIGIP_PEER_PASSWORD.
+func testAccCmTrustPreCheck(t *testing.T) {
+	testAcctPreCheck(t)
+	for _, s := range [...]string{"BIGIP_PEER_NAME", "BIGIP_PEER_ADDRESS", "BIGIP_PEER_USER", "BIGIP_PEER_PASSWORD"} {
+		if os.Getenv(s) == "" {
+			t.Skipf("%s is not set, skipping trust test", s)
+		}
+	}
+}
+
+func TestAccBigipCmTrustCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCmTrustPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckCmTrustDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testCmTrustResource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-peer", "device_name", os.Getenv("BIGIP_PEER_NAME")),
+					resource.TestCheckResourceAttr("bigip_cm_trust.test-peer", "type", "peer"),
+					resource.TestCheckResourceAttrSet("bigip_cm_trust.test-peer", "status"),
+				),
+			},
+			{
+				ResourceName:            "bigip_cm_trust.test-peer",
+				ImportStateId:           os.Getenv("BIGIP_PEER_NAME"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
+func testCheckCmTrustDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_cm_trust" {
+			continue
+		}
+		trustType, _, err := getCmTrustDevice(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if trustType != "" {
+			return fmt.Errorf("device %s still in trust ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testCmTrustResource() string {
+	return fmt.Sprintf(`
+resource "bigip_cm_trust" "test-peer" {
+  device_name = "%s"
+  address     = "%s"
+  username    = "%s"
+  password    = "%s"
+}
+`, os.Getenv("BIGIP_PEER_NAME"), os.Getenv("BIGIP_PEER_ADDRESS"), os.Getenv("BIGIP_PEER_USER"), os.Getenv("BIGIP_PEER_PASSWORD"))
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCmTrustDevice(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	polls := 0
+	mux.HandleFunc("/mgmt/tm/cm/trust-domain/Root", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			_, _ = fmt.Fprintf(w, `{"caDevices":["/Common/bigip1.example.com"],"trustDevices":["/Common/bigip1.example.com"]}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"caDevices":["/Common/bigip1.example.com","/Common/bigip2.example.com"],
+"trustDevices":["/Common/bigip1.example.com","/Common/bigip2.example.com","/Common/bigip3.example.com"]}`)
+	})
+	for _, name := range []string{"bigip2.example.com", "bigip3.example.com"} {
+		name := name
+		mux.HandleFunc("/mgmt/tm/cm/device/~Common~"+name, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"name":"%s","managementIp":"192.0.2.2","failoverState":"standby"}`, name)
+		})
+	}
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	assert.NoError(t, waitCmTrustDevice(context.Background(), client, "bigip2.example.com"))
+	assert.Equal(t, 3, polls)
+
+	trustType, device, err := getCmTrustDevice(client, "bigip2.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "peer", trustType)
+	assert.Equal(t, "standby", device.FailoverState)
+
+	trustType, _, err = getCmTrustDevice(client, "bigip3.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "subordinate", trustType)
+
+	trustType, _, err = getCmTrustDevice(client, "bigip4.example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, trustType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.EqualError(t, waitCmTrustDevice(ctx, client, "bigip4.example.com"), "timed out waiting for device bigip4.example.com to join the trust")
+}
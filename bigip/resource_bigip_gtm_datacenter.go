@@ -0,0 +1,187 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmDatacenter = "gtm/datacenter"
+
+// bigip.Datacenter has no location or prober settings and go-bigip cannot
+// read or modify a single datacenter.
+type gtmDatacenter struct {
+	Name             string `json:"name,omitempty"`
+	FullPath         string `json:"fullPath,omitempty"`
+	Description      string `json:"description"`
+	Contact          string `json:"contact"`
+	Location         string `json:"location"`
+	Enabled          bool   `json:"enabled,omitempty"`
+	Disabled         bool   `json:"disabled,omitempty"`
+	ProberPreference string `json:"proberPreference,omitempty"`
+	ProberFallback   string `json:"proberFallback,omitempty"`
+	ProberPool       string `json:"proberPool,omitempty"`
+}
+
+var gtmProberPreferences = []string{"inside-datacenter", "outside-datacenter", "pool"}
+
+func resourceBigipGtmDatacenter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmDatacenterCreate,
+		ReadContext:   resourceBigipGtmDatacenterRead,
+		UpdateContext: resourceBigipGtmDatacenterUpdate,
+		DeleteContext: resourceBigipGtmDatacenterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM Datacenter",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"contact": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the administrator responsible for the datacenter",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Physical location of the datacenter",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables the datacenter, disabling it takes all of its servers out of load balancing",
+			},
+			"prober_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gtmProberPreferences, false),
+				Description:  "Type of prober used to monitor the servers of the datacenter",
+			},
+			"prober_fallback": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(append([]string{"any-available", "none"}, gtmProberPreferences...), false),
+				Description:  "Type of prober used when the preferred prober is not available",
+			},
+			"prober_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Prober pool used when `prober_preference` or `prober_fallback` is `pool`",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmDatacenterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating GTM Datacenter:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmDatacenterConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmDatacenter)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Datacenter (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmDatacenterRead(ctx, d, meta)
+}
+
+func resourceBigipGtmDatacenterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching GTM Datacenter " + name)
+
+	var dc gtmDatacenter
+	found, err := getTmEntity(client, &dc, uriGtmDatacenter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Datacenter (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Datacenter (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", dc.FullPath)
+	_ = d.Set("description", dc.Description)
+	_ = d.Set("contact", dc.Contact)
+	_ = d.Set("location", dc.Location)
+	_ = d.Set("enabled", !dc.Disabled)
+	_ = d.Set("prober_preference", dc.ProberPreference)
+	_ = d.Set("prober_fallback", dc.ProberFallback)
+	_ = d.Set("prober_pool", dc.ProberPool)
+	return nil
+}
+
+func resourceBigipGtmDatacenterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Datacenter:%+v ", name)
+
+	err := patchTmEntity(client, getGtmDatacenterConfig(d), uriGtmDatacenter, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Datacenter (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmDatacenterRead(ctx, d, meta)
+}
+
+func resourceBigipGtmDatacenterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Datacenter " + name)
+
+	err := client.DeleteDatacenter(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Datacenter (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmDatacenterConfig(d *schema.ResourceData) *gtmDatacenter {
+	config := &gtmDatacenter{
+		Description:      d.Get("description").(string),
+		Contact:          d.Get("contact").(string),
+		Location:         d.Get("location").(string),
+		ProberPreference: d.Get("prober_preference").(string),
+		ProberFallback:   d.Get("prober_fallback").(string),
+		ProberPool:       d.Get("prober_pool").(string),
+	}
+	if d.Get("enabled").(bool) {
+		config.Enabled = true
+	} else {
+		config.Disabled = true
+	}
+	return config
+}
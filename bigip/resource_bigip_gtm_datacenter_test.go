@@ -0,0 +1,96 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestGtmDatacenterName = "/Common/test-gtm-dc"
+
+func TestAccBigipGtmDatacenterCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_datacenter", uriGtmDatacenter),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmDatacenterResource(TestGtmDatacenterName, "Frankfurt", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmDatacenter, TestGtmDatacenterName),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-dc", "name", TestGtmDatacenterName),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-dc", "location", "Frankfurt"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-dc", "enabled", "true"),
+				),
+			},
+			{
+				Config: testGtmDatacenterResource(TestGtmDatacenterName, "Amsterdam", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-dc", "location", "Amsterdam"),
+					resource.TestCheckResourceAttr("bigip_gtm_datacenter.test-dc", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_datacenter.test-dc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmEntityExists(uri, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var entity map[string]interface{}
+		found, err := getTmEntity(client, &entity, uri, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s %s does not exist ", uri, name)
+		}
+		return nil
+	}
+}
+
+func testCheckGtmEntitiesDestroyed(resourceType, uri string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			var entity map[string]interface{}
+			found, err := getTmEntity(client, &entity, uri, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("%s %s not destroyed ", uri, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testGtmDatacenterResource(name, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "bigip_gtm_datacenter" "test-dc" {
+  name     = "%s"
+  contact  = "noc@example.com"
+  location = "%s"
+  enabled  = %t
+}
+`, name, location, enabled)
+}
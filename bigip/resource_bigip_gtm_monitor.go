@@ -0,0 +1,348 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmMonitor = "gtm/monitor"
+
+var gtmMonitorTypes = []string{"http", "https", "tcp", "gateway_icmp", "bigip"}
+
+// Arguments that only some monitor types accept. Everything else in the schema
+// is common to all types.
+var gtmMonitorTypeFields = map[string][]string{
+	"send":                     {"http", "https", "tcp"},
+	"receive":                  {"http", "https", "tcp"},
+	"reverse":                  {"http", "https", "tcp"},
+	"transparent":              {"http", "https", "tcp", "gateway_icmp"},
+	"cipherlist":               {"https"},
+	"cert":                     {"https"},
+	"key":                      {"https"},
+	"compatibility":            {"https"},
+	"probe_interval":           {"gateway_icmp"},
+	"probe_attempts":           {"gateway_icmp"},
+	"aggregate_dynamic_ratios": {"bigip"},
+}
+
+// go-bigip only wraps http monitors and its ModifyGtmmonitor sends no body, so
+// monitors are managed through the generic REST helpers.
+type gtmMonitor struct {
+	Name                   string `json:"name,omitempty"`
+	FullPath               string `json:"fullPath,omitempty"`
+	DefaultsFrom           string `json:"defaultsFrom,omitempty"`
+	Description            string `json:"description"`
+	Destination            string `json:"destination,omitempty"`
+	Interval               int    `json:"interval,omitempty"`
+	Timeout                int    `json:"timeout,omitempty"`
+	ProbeTimeout           int    `json:"probeTimeout,omitempty"`
+	IgnoreDownResponse     string `json:"ignoreDownResponse,omitempty"`
+	Send                   string `json:"send,omitempty"`
+	Recv                   string `json:"recv,omitempty"`
+	Reverse                string `json:"reverse,omitempty"`
+	Transparent            string `json:"transparent,omitempty"`
+	Cipherlist             string `json:"cipherlist,omitempty"`
+	Cert                   string `json:"cert,omitempty"`
+	Key                    string `json:"key,omitempty"`
+	Compatibility          string `json:"compatibility,omitempty"`
+	ProbeInterval          int    `json:"probeInterval,omitempty"`
+	ProbeAttempts          int    `json:"probeAttempts,omitempty"`
+	AggregateDynamicRatios string `json:"aggregateDynamicRatios,omitempty"`
+}
+
+func resourceBigipGtmMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmMonitorCreate,
+		ReadContext:   resourceBigipGtmMonitorRead,
+		UpdateContext: resourceBigipGtmMonitorUpdate,
+		DeleteContext: resourceBigipGtmMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBigipGtmMonitorImport,
+		},
+		CustomizeDiff: resourceBigipGtmMonitorCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the GTM Monitor",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gtmMonitorTypes, false),
+				Description:  "Type of the GTM Monitor",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Parent monitor the settings are inherited from, defaults to the built-in monitor of the type",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address and port probed, `*:*` probes the address and port of the monitored virtual server",
+			},
+			"interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds between two probes",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds without a successful probe before the resource is marked down",
+			},
+			"probe_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds after the first probe of a resource before its status is reported",
+			},
+			"ignore_down_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Accept a down response only when the timeout expires without a successful probe",
+			},
+			"send": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Request sent to the monitored resource, for `http`, `https` and `tcp` monitors",
+			},
+			"receive": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Regular expression matched against the response, for `http`, `https` and `tcp` monitors",
+			},
+			"reverse": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Mark the resource down when `receive` matches, for `http`, `https` and `tcp` monitors",
+			},
+			"transparent": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Probe `destination` through the monitored resource, for `http`, `https`, `tcp` and `gateway_icmp` monitors",
+			},
+			"cipherlist": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OpenSSL cipher string used by `https` monitors",
+			},
+			"cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate presented by `https` monitors",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Key of the client certificate presented by `https` monitors",
+			},
+			"compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Set the OpenSSL `ALL` option for `https` monitors",
+			},
+			"probe_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds between the attempts of one probe, for `gateway_icmp` monitors",
+			},
+			"probe_attempts": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Failed attempts before a probe fails, for `gateway_icmp` monitors",
+			},
+			"aggregate_dynamic_ratios": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "average-nodes", "sum-nodes", "average-members", "sum-members"}, false),
+				Description:  "How the dynamic ratios of the LTM nodes or pool members are combined, for `bigip` monitors",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	monitorType := d.Get("type").(string)
+	log.Printf("[INFO] Creating GTM Monitor:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmMonitorConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmMonitor, gtmMonitorURIType(monitorType))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Monitor (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmMonitorRead(ctx, d, meta)
+}
+
+func resourceBigipGtmMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	monitorType := d.Get("type").(string)
+	log.Println("[INFO] Fetching GTM Monitor " + name)
+
+	var monitor gtmMonitor
+	found, err := getTmEntity(client, &monitor, uriGtmMonitor, gtmMonitorURIType(monitorType), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Monitor (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Monitor (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", monitor.FullPath)
+	_ = d.Set("defaults_from", monitor.DefaultsFrom)
+	_ = d.Set("description", monitor.Description)
+	_ = d.Set("destination", monitor.Destination)
+	_ = d.Set("interval", monitor.Interval)
+	_ = d.Set("timeout", monitor.Timeout)
+	_ = d.Set("probe_timeout", monitor.ProbeTimeout)
+	_ = d.Set("ignore_down_response", monitor.IgnoreDownResponse)
+	_ = d.Set("send", monitor.Send)
+	_ = d.Set("receive", monitor.Recv)
+	_ = d.Set("reverse", monitor.Reverse)
+	_ = d.Set("transparent", monitor.Transparent)
+	_ = d.Set("cipherlist", monitor.Cipherlist)
+	_ = d.Set("cert", monitor.Cert)
+	_ = d.Set("key", monitor.Key)
+	_ = d.Set("compatibility", monitor.Compatibility)
+	_ = d.Set("probe_interval", monitor.ProbeInterval)
+	_ = d.Set("probe_attempts", monitor.ProbeAttempts)
+	_ = d.Set("aggregate_dynamic_ratios", monitor.AggregateDynamicRatios)
+	return nil
+}
+
+func resourceBigipGtmMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Monitor:%+v ", name)
+
+	err := patchTmEntity(client, getGtmMonitorConfig(d), uriGtmMonitor, gtmMonitorURIType(d.Get("type").(string)), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Monitor (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmMonitorRead(ctx, d, meta)
+}
+
+func resourceBigipGtmMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Monitor " + name)
+
+	err := deleteTmEntity(client, uriGtmMonitor, gtmMonitorURIType(d.Get("type").(string)), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Monitor (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// The import ID is the monitor type followed by the monitor path, e.g. https:/Common/mon1.
+func resourceBigipGtmMonitorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	monitorType, name, err := parseGtmImportID(d.Id(), gtmMonitorTypes)
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("type", monitorType)
+	d.SetId(name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceBigipGtmMonitorCustomizeDiff rejects type specific arguments that
+// the chosen monitor type does not accept, BIG-IP would otherwise fail the
+// request with a generic "unexpected property" error.
+func resourceBigipGtmMonitorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	monitorType := d.Get("type").(string)
+	var invalid []string
+	for field, types := range gtmMonitorTypeFields {
+		if _, ok := d.GetOk(field); ok && !contains(types, monitorType) {
+			invalid = append(invalid, field)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("%s not supported by %s GTM monitors", strings.Join(invalid, ", "), monitorType)
+	}
+	return nil
+}
+
+func gtmMonitorURIType(monitorType string) string {
+	return strings.ReplaceAll(monitorType, "_", "-")
+}
+
+func getGtmMonitorConfig(d *schema.ResourceData) *gtmMonitor {
+	return &gtmMonitor{
+		DefaultsFrom:           d.Get("defaults_from").(string),
+		Description:            d.Get("description").(string),
+		Destination:            d.Get("destination").(string),
+		Interval:               d.Get("interval").(int),
+		Timeout:                d.Get("timeout").(int),
+		ProbeTimeout:           d.Get("probe_timeout").(int),
+		IgnoreDownResponse:     d.Get("ignore_down_response").(string),
+		Send:                   d.Get("send").(string),
+		Recv:                   d.Get("receive").(string),
+		Reverse:                d.Get("reverse").(string),
+		Transparent:            d.Get("transparent").(string),
+		Cipherlist:             d.Get("cipherlist").(string),
+		Cert:                   d.Get("cert").(string),
+		Key:                    d.Get("key").(string),
+		Compatibility:          d.Get("compatibility").(string),
+		ProbeInterval:          d.Get("probe_interval").(int),
+		ProbeAttempts:          d.Get("probe_attempts").(int),
+		AggregateDynamicRatios: d.Get("aggregate_dynamic_ratios").(string),
+	}
+}
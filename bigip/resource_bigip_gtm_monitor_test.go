@@ -0,0 +1,78 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestGtmMonitorName = "/Common/test-gtm-monitor"
+
+func TestAccBigipGtmMonitorCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_monitor", uriGtmMonitor+"/https"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmMonitorResource("https", 30),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmMonitor+"/https", TestGtmMonitorName),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-monitor", "name", TestGtmMonitorName),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-monitor", "defaults_from", "/Common/https"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-monitor", "interval", "30"),
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-monitor", "receive", "200 OK"),
+				),
+			},
+			{
+				Config: testGtmMonitorResource("https", 15),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_monitor.test-monitor", "interval", "15"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_monitor.test-monitor",
+				ImportStateId:     "https:" + TestGtmMonitorName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigipGtmMonitorInvalidField(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGtmMonitorResource("gateway_icmp", 30),
+				ExpectError: regexp.MustCompile("receive, send not supported by gateway_icmp GTM monitors"),
+			},
+		},
+	})
+}
+
+func testGtmMonitorResource(monitorType string, interval int) string {
+	return fmt.Sprintf(`
+resource "bigip_gtm_monitor" "test-monitor" {
+  name     = "%s"
+  type     = "%s"
+  interval = %d
+  timeout  = 91
+  send     = "GET /health HTTP/1.1\r\nHost: example.com\r\nConnection: close\r\n\r\n"
+  receive  = "200 OK"
+}
+`, TestGtmMonitorName, monitorType, interval)
+}
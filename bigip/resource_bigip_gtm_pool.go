@@ -0,0 +1,413 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmPool = "gtm/pool"
+
+var gtmRecordTypes = []string{"a", "aaaa", "cname", "mx", "srv"}
+
+var gtmPoolLbModes = []string{
+	"completion-rate", "cpu", "drop-packet", "fallback-ip", "fewest-hops", "global-availability",
+	"kilobytes-per-second", "least-connections", "lowest-round-trip-time", "none", "packet-rate",
+	"quality-of-service", "ratio", "return-to-dns", "round-robin", "static-persistence", "topology",
+	"virtual-server-capacity", "virtual-server-score",
+}
+
+// go-bigip only knows A pools, and sends their attributes with snake_case keys.
+type gtmPool struct {
+	Name               string           `json:"name,omitempty"`
+	FullPath           string           `json:"fullPath,omitempty"`
+	Description        string           `json:"description"`
+	LoadBalancingMode  string           `json:"loadBalancingMode,omitempty"`
+	AlternateMode      string           `json:"alternateMode,omitempty"`
+	FallbackMode       string           `json:"fallbackMode,omitempty"`
+	FallbackIp         string           `json:"fallbackIp,omitempty"`
+	Ttl                int              `json:"ttl,omitempty"`
+	MaxAnswersReturned int              `json:"maxAnswersReturned,omitempty"`
+	Monitor            string           `json:"monitor,omitempty"`
+	Enabled            bool             `json:"enabled,omitempty"`
+	Disabled           bool             `json:"disabled,omitempty"`
+	Members            *[]gtmPoolMember `json:"members,omitempty"`
+	MembersReference   *gtmPoolMembers  `json:"membersReference,omitempty"`
+}
+
+type gtmPoolMember struct {
+	Name         string `json:"name"`
+	FullPath     string `json:"fullPath,omitempty"`
+	Enabled      bool   `json:"enabled,omitempty"`
+	Disabled     bool   `json:"disabled,omitempty"`
+	MemberOrder  int    `json:"memberOrder"`
+	Ratio        int    `json:"ratio,omitempty"`
+	Port         int    `json:"port,omitempty"`
+	Priority     int    `json:"priority,omitempty"`
+	Weight       int    `json:"weight,omitempty"`
+	StaticTarget string `json:"staticTarget,omitempty"`
+}
+
+type gtmPoolMembers struct {
+	Items []gtmPoolMember `json:"items,omitempty"`
+}
+
+func resourceBigipGtmPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmPoolCreate,
+		ReadContext:   resourceBigipGtmPoolRead,
+		UpdateContext: resourceBigipGtmPoolUpdate,
+		DeleteContext: resourceBigipGtmPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBigipGtmPoolImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM Pool",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gtmRecordTypes, false),
+				Description:  "DNS record type served by the pool",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"load_balancing_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				ValidateFunc: validation.StringInSlice(gtmPoolLbModes, false),
+				Description:  "Preferred load balancing mode of the pool members",
+			},
+			"alternate_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gtmPoolLbModes, false),
+				Description:  "Load balancing mode used when the preferred mode fails",
+			},
+			"fallback_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gtmPoolLbModes, false),
+				Description:  "Load balancing mode used when the alternate mode fails",
+			},
+			"fallback_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Address returned when `fallback_mode` is `fallback-ip`, only for `a` and `aaaa` pools",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time to live of the DNS answers in seconds",
+			},
+			"max_answers_returned": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 500),
+				Description:  "Maximum number of available members returned in one answer",
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Health monitors of the pool members",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables the pool for load balancing",
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Members of the pool, in member order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "For `a` and `aaaa` pools the GTM server and virtual server as `/Common/server:virtual-server`, otherwise the target host name",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Enables the member for load balancing",
+						},
+						"ratio": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Weight of the member for the `ratio` load balancing mode",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "Service port, only for `srv` pools",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Priority of the target, only for `mx` and `srv` pools",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Weight of the target, only for `srv` pools",
+						},
+						"static_target": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+							Description:  "Answer with the host name without resolving it through a wide IP, only for `cname` pools",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	poolType := d.Get("type").(string)
+	log.Printf("[INFO] Creating GTM Pool:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkGtmPoolMembers(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmPoolConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmPool, poolType)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Pool (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmPoolRead(ctx, d, meta)
+}
+
+func resourceBigipGtmPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	poolType := d.Get("type").(string)
+	log.Println("[INFO] Fetching GTM Pool " + name)
+
+	var pool gtmPool
+	found, err := getTmEntity(client, &pool, uriGtmPool, poolType, tmPath(name)+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Pool (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Pool (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", pool.FullPath)
+	_ = d.Set("description", pool.Description)
+	_ = d.Set("load_balancing_mode", pool.LoadBalancingMode)
+	_ = d.Set("alternate_mode", pool.AlternateMode)
+	_ = d.Set("fallback_mode", pool.FallbackMode)
+	_ = d.Set("fallback_ip", pool.FallbackIp)
+	_ = d.Set("ttl", pool.Ttl)
+	_ = d.Set("max_answers_returned", pool.MaxAnswersReturned)
+	_ = d.Set("monitor", pool.Monitor)
+	_ = d.Set("enabled", !pool.Disabled)
+
+	var members []interface{}
+	if pool.MembersReference != nil {
+		for _, m := range pool.MembersReference.Items {
+			memberName := m.Name
+			if m.FullPath != "" {
+				memberName = m.FullPath
+			}
+			members = append(members, map[string]interface{}{
+				"name":          memberName,
+				"enabled":       !m.Disabled,
+				"ratio":         m.Ratio,
+				"port":          m.Port,
+				"priority":      m.Priority,
+				"weight":        m.Weight,
+				"static_target": m.StaticTarget,
+			})
+		}
+	}
+	if err := d.Set("member", members); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving member to state for GTM Pool (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipGtmPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Pool:%+v ", name)
+
+	if d.HasChange("member") {
+		if err := checkGtmPoolMembers(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	err := patchTmEntity(client, getGtmPoolConfig(d), uriGtmPool, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Pool (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmPoolRead(ctx, d, meta)
+}
+
+func resourceBigipGtmPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Pool " + name)
+
+	err := deleteTmEntity(client, uriGtmPool, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Pool (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// The import ID is the record type followed by the pool path, e.g. a:/Common/pool1.
+func resourceBigipGtmPoolImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	recordType, name, err := parseGtmImportID(d.Id(), gtmRecordTypes)
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("type", recordType)
+	d.SetId(name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkGtmPoolMembers makes sure the virtual servers referenced by a/aaaa
+// members exist on their GTM servers before the pool is sent to BIG-IP. It
+// runs at apply time, so servers and virtual servers created or changed in the
+// same apply are already there.
+func checkGtmPoolMembers(client *bigip.BigIP, d *schema.ResourceData) error {
+	poolType := d.Get("type").(string)
+	if poolType != "a" && poolType != "aaaa" {
+		return nil
+	}
+	var names []string
+	for _, item := range d.Get("member").([]interface{}) {
+		names = append(names, item.(map[string]interface{})["name"].(string))
+	}
+	return validateGtmPoolMembers(client, names)
+}
+
+func validateGtmPoolMembers(client *bigip.BigIP, members []string) error {
+	for _, member := range members {
+		server, virtualServer, ok := strings.Cut(member, ":")
+		if !ok || server == "" || virtualServer == "" {
+			return fmt.Errorf("GTM pool member %q must be in the form /Partition/server:virtual-server", member)
+		}
+		var entity map[string]interface{}
+		found, err := getTmEntity(client, &entity, uriGtmServer, server)
+		if err != nil {
+			return fmt.Errorf("error checking GTM server %s of pool member %s: %v", server, member, err)
+		}
+		if !found {
+			return fmt.Errorf("GTM server %s of pool member %s does not exist", server, member)
+		}
+		found, err = getTmEntity(client, &entity, uriGtmServer, server, "virtual-servers", virtualServer)
+		if err != nil {
+			return fmt.Errorf("error checking virtual server %s of GTM server %s: %v", virtualServer, server, err)
+		}
+		if !found {
+			return fmt.Errorf("GTM server %s has no virtual server %s, referenced by pool member %s", server, virtualServer, member)
+		}
+	}
+	return nil
+}
+
+func parseGtmImportID(id string, types []string) (string, string, error) {
+	objectType, name, ok := strings.Cut(id, ":")
+	if !ok || !contains(types, objectType) || name == "" {
+		return "", "", fmt.Errorf("invalid import id %q, expected <type>:<full path>, e.g. %s:/Common/name, with type one of %s", id, types[0], strings.Join(types, ", "))
+	}
+	return objectType, name, nil
+}
+
+func getGtmPoolConfig(d *schema.ResourceData) *gtmPool {
+	poolType := d.Get("type").(string)
+	config := &gtmPool{
+		Description:        d.Get("description").(string),
+		LoadBalancingMode:  d.Get("load_balancing_mode").(string),
+		AlternateMode:      d.Get("alternate_mode").(string),
+		FallbackMode:       d.Get("fallback_mode").(string),
+		Ttl:                d.Get("ttl").(int),
+		MaxAnswersReturned: d.Get("max_answers_returned").(int),
+		Monitor:            d.Get("monitor").(string),
+	}
+	if poolType == "a" || poolType == "aaaa" {
+		config.FallbackIp = d.Get("fallback_ip").(string)
+	}
+	if d.Get("enabled").(bool) {
+		config.Enabled = true
+	} else {
+		config.Disabled = true
+	}
+	members := []gtmPoolMember{}
+	for i, item := range d.Get("member").([]interface{}) {
+		m := item.(map[string]interface{})
+		member := gtmPoolMember{
+			Name:        m["name"].(string),
+			MemberOrder: i,
+			Ratio:       m["ratio"].(int),
+		}
+		if m["enabled"].(bool) {
+			member.Enabled = true
+		} else {
+			member.Disabled = true
+		}
+		switch poolType {
+		case "srv":
+			member.Port = m["port"].(int)
+			member.Priority = m["priority"].(int)
+			member.Weight = m["weight"].(int)
+		case "mx":
+			member.Priority = m["priority"].(int)
+		case "cname":
+			member.StaticTarget = m["static_target"].(string)
+		}
+		members = append(members, member)
+	}
+	config.Members = &members
+	return config
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestGtmPoolName = "/Common/test-gtm-pool"
+
+func TestAccBigipGtmPoolCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_pool", uriGtmPool+"/a"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmPoolResource("round-robin", "web"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmPool+"/a", TestGtmPoolName),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-pool", "name", TestGtmPoolName),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-pool", "load_balancing_mode", "round-robin"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-pool", "member.#", "1"),
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-pool", "member.0.name", TestGtmServerName+":web"),
+				),
+			},
+			{
+				Config: testGtmPoolResource("ratio", "web"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_pool.test-pool", "load_balancing_mode", "ratio"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_pool.test-pool",
+				ImportStateId:     "a:" + TestGtmPoolName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testGtmPoolResource("ratio", "missing"),
+				ExpectError: regexp.MustCompile("has no virtual server missing"),
+			},
+		},
+	})
+}
+
+func testGtmPoolResource(lbMode, virtualServer string) string {
+	return testGtmServerResource("203.0.113.10") + fmt.Sprintf(`
+resource "bigip_gtm_pool" "test-pool" {
+  name                = "%s"
+  type                = "a"
+  load_balancing_mode = "%s"
+  fallback_ip         = "192.0.2.1"
+  ttl                 = 30
+  member {
+    name = "${bigip_gtm_server.test-server.name}:%s"
+  }
+}
+`, TestGtmPoolName, lbMode, virtualServer)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGtmPoolMembers(t *testing.T) {
+	setup()
+	defer teardown()
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested object was not found.","errorStack":[]}`)
+	}
+	mux.HandleFunc("/mgmt/tm/gtm/server/", notFound)
+	mux.HandleFunc("/mgmt/tm/gtm/server/~Common~web-fra", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"web-fra","fullPath":"/Common/web-fra"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/gtm/server/~Common~web-fra/virtual-servers/", notFound)
+	mux.HandleFunc("/mgmt/tm/gtm/server/~Common~web-fra/virtual-servers/www", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"www","destination":"10.0.1.20:443"}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	assert.NoError(t, validateGtmPoolMembers(client, []string{"/Common/web-fra:www"}))
+	err := validateGtmPoolMembers(client, []string{"/Common/web-ams:www"})
+	assert.ErrorContains(t, err, "GTM server /Common/web-ams of pool member /Common/web-ams:www does not exist")
+	err = validateGtmPoolMembers(client, []string{"/Common/web-fra:www", "/Common/web-fra:ww"})
+	assert.ErrorContains(t, err, "GTM server /Common/web-fra has no virtual server ww")
+	err = validateGtmPoolMembers(client, []string{"/Common/web-fra"})
+	assert.ErrorContains(t, err, "must be in the form")
+}
+
+func TestParseGtmImportID(t *testing.T) {
+	recordType, name, err := parseGtmImportID("aaaa:/Common/www.example.com", gtmRecordTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, "aaaa", recordType)
+	assert.Equal(t, "/Common/www.example.com", name)
+
+	_, _, err = parseGtmImportID("/Common/www.example.com", gtmRecordTypes)
+	assert.Error(t, err)
+	_, _, err = parseGtmImportID("ptr:/Common/www.example.com", gtmRecordTypes)
+	assert.Error(t, err)
+}
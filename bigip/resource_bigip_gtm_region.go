@@ -0,0 +1,244 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmRegion = "gtm/region"
+
+// Match types shared by regions and both sides of a topology record. LDNS
+// (request source) matches cannot use datacenter or pool.
+var gtmTopologySourceTypes = []string{"continent", "country", "state", "isp", "subnet", "region"}
+var gtmTopologyMatchTypes = append(append([]string{}, gtmTopologySourceTypes...), "datacenter", "pool")
+
+type gtmRegion struct {
+	Name          string            `json:"name,omitempty"`
+	FullPath      string            `json:"fullPath,omitempty"`
+	Description   string            `json:"description"`
+	RegionMembers []gtmRegionMember `json:"regionMembers"`
+}
+
+// A region member name is the match itself, e.g. "country DE" or "not subnet 10.0.0.0/8".
+type gtmRegionMember struct {
+	Name string `json:"name"`
+}
+
+func gtmTopologyMatchSchema(types []string, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		ForceNew:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(types, false),
+					Description:  "What the match compares",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Value compared, e.g. a continent or country code, a subnet, or the full path of a region, datacenter or pool",
+				},
+				"negate": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					ForceNew:    true,
+					Description: "Match everything except the value",
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmRegion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmRegionCreate,
+		ReadContext:   resourceBigipGtmRegionRead,
+		UpdateContext: resourceBigipGtmRegionUpdate,
+		DeleteContext: resourceBigipGtmRegionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the GTM Region",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"member": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Matches grouped in the region, a request is in the region when any of them matches",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(gtmTopologyMatchTypes, false),
+							Description:  "What the match compares",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value compared, e.g. a continent or country code, a subnet, or the full path of a region, datacenter or pool",
+						},
+						"negate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Match everything except the value",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmRegionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating GTM Region:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmRegionConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmRegion)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Region (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmRegionRead(ctx, d, meta)
+}
+
+func resourceBigipGtmRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching GTM Region " + name)
+
+	var region gtmRegion
+	found, err := getTmEntity(client, &region, uriGtmRegion, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Region (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Region (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", region.FullPath)
+	_ = d.Set("description", region.Description)
+
+	var members []interface{}
+	for _, m := range region.RegionMembers {
+		matchType, value, negate, err := parseGtmTopologyMatch(m.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		members = append(members, map[string]interface{}{
+			"type":   matchType,
+			"value":  value,
+			"negate": negate,
+		})
+	}
+	if err := d.Set("member", members); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving member to state for GTM Region (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipGtmRegionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Region:%+v ", name)
+
+	err := patchTmEntity(client, getGtmRegionConfig(d), uriGtmRegion, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Region (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmRegionRead(ctx, d, meta)
+}
+
+func resourceBigipGtmRegionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Region " + name)
+
+	err := deleteTmEntity(client, uriGtmRegion, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Region (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmRegionConfig(d *schema.ResourceData) *gtmRegion {
+	config := &gtmRegion{
+		Description:   d.Get("description").(string),
+		RegionMembers: []gtmRegionMember{},
+	}
+	for _, item := range d.Get("member").([]interface{}) {
+		config.RegionMembers = append(config.RegionMembers, gtmRegionMember{Name: gtmTopologyMatchFromMap(item.(map[string]interface{}))})
+	}
+	return config
+}
+
+func gtmTopologyMatchFromMap(m map[string]interface{}) string {
+	return formatGtmTopologyMatch(m["type"].(string), m["value"].(string), m["negate"].(bool))
+}
+
+func formatGtmTopologyMatch(matchType, value string, negate bool) string {
+	match := matchType + " " + value
+	if negate {
+		match = "not " + match
+	}
+	return match
+}
+
+func parseGtmTopologyMatch(match string) (string, string, bool, error) {
+	match = strings.TrimSpace(match)
+	negate := false
+	if rest, ok := strings.CutPrefix(match, "not "); ok {
+		negate = true
+		match = rest
+	}
+	matchType, value, ok := strings.Cut(match, " ")
+	if !ok || matchType == "" {
+		return "", "", false, fmt.Errorf("unsupported GTM topology match %q", match)
+	}
+	return matchType, strings.TrimSpace(value), negate, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestGtmRegionName = "/Common/test-gtm-region"
+
+func TestAccBigipGtmRegionCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_region", uriGtmRegion),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmRegionResource("DE"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmRegion, TestGtmRegionName),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "name", TestGtmRegionName),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "member.#", "3"),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "member.1.value", "DE"),
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "member.2.negate", "true"),
+				),
+			},
+			{
+				Config: testGtmRegionResource("FR"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_region.test-region", "member.1.value", "FR"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_region.test-region",
+				ImportStateId:     TestGtmRegionName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGtmRegionResource(country string) string {
+	return fmt.Sprintf(`
+resource "bigip_gtm_region" "test-region" {
+  name = "%s"
+  member {
+    type  = "continent"
+    value = "EU"
+  }
+  member {
+    type  = "country"
+    value = "%s"
+  }
+  member {
+    type   = "subnet"
+    value  = "10.0.0.0/8"
+    negate = true
+  }
+}
+`, TestGtmRegionName, country)
+}
@@ -0,0 +1,374 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmServer = "gtm/server"
+
+// bigip.Server sends virtual server discovery as a boolean under the wrong key
+// and cannot carry the virtual server translation, monitor or link settings.
+type gtmServer struct {
+	Name                    string                    `json:"name,omitempty"`
+	FullPath                string                    `json:"fullPath,omitempty"`
+	Description             string                    `json:"description"`
+	Datacenter              string                    `json:"datacenter,omitempty"`
+	Product                 string                    `json:"product,omitempty"`
+	Monitor                 string                    `json:"monitor,omitempty"`
+	VirtualServerDiscovery  string                    `json:"virtualServerDiscovery,omitempty"`
+	LinkDiscovery           string                    `json:"linkDiscovery,omitempty"`
+	ProberPreference        string                    `json:"proberPreference,omitempty"`
+	ProberFallback          string                    `json:"proberFallback,omitempty"`
+	Enabled                 bool                      `json:"enabled,omitempty"`
+	Disabled                bool                      `json:"disabled,omitempty"`
+	Addresses               []gtmServerAddress        `json:"addresses,omitempty"`
+	VirtualServers          *[]gtmServerVirtualServer `json:"virtualServers,omitempty"`
+	VirtualServersReference *gtmServerVirtualServers  `json:"virtualServersReference,omitempty"`
+}
+
+type gtmServerAddress struct {
+	Name        string `json:"name"`
+	DeviceName  string `json:"deviceName,omitempty"`
+	Translation string `json:"translation,omitempty"`
+}
+
+type gtmServerVirtualServer struct {
+	Name               string `json:"name"`
+	Destination        string `json:"destination"`
+	Description        string `json:"description,omitempty"`
+	TranslationAddress string `json:"translationAddress,omitempty"`
+	TranslationPort    int    `json:"translationPort,omitempty"`
+	Monitor            string `json:"monitor,omitempty"`
+	ExplicitLinkName   string `json:"explicitLinkName,omitempty"`
+}
+
+type gtmServerVirtualServers struct {
+	Items []gtmServerVirtualServer `json:"items,omitempty"`
+}
+
+var gtmServerProducts = []string{
+	"alteon-ace-director", "bigip", "cacheflow", "cisco-css", "cisco-local-director-v2", "cisco-local-director-v3",
+	"cisco-server-load-balancer", "extreme", "foundry-server-iron", "generic-host", "generic-load-balancer",
+	"netapp", "radware-wsd", "redundant-bigip", "single-bigip", "sun-solaris", "windows-2000-server", "windows-nt-4.0",
+}
+
+func resourceBigipGtmServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmServerCreate,
+		ReadContext:   resourceBigipGtmServerRead,
+		UpdateContext: resourceBigipGtmServerUpdate,
+		DeleteContext: resourceBigipGtmServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the GTM Server",
+				ValidateFunc: validateF5Name,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"datacenter": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Datacenter the server belongs to",
+			},
+			"product": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "bigip",
+				ValidateFunc: validation.StringInSlice(gtmServerProducts, false),
+				Description:  "Type of the server, `bigip` for BIG-IP systems or for example `generic-host`",
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Health monitors of the server, for example `/Common/bigip` or `/Common/gateway_icmp`",
+			},
+			"virtual_server_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "enabled-no-delete"}, false),
+				Description:  "Discover the virtual servers of a BIG-IP server through iQuery instead of listing them in `virtual_server`",
+			},
+			"link_discovery": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled", "enabled-no-delete"}, false),
+				Description:  "Discover the links of a BIG-IP server through iQuery",
+			},
+			"prober_preference": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(append([]string{"inherit"}, gtmProberPreferences...), false),
+				Description:  "Type of prober used to monitor the server",
+			},
+			"prober_fallback": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(append([]string{"inherit", "any-available", "none"}, gtmProberPreferences...), false),
+				Description:  "Type of prober used when the preferred prober is not available",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables the server for load balancing",
+			},
+			"address": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Self IP addresses of the server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "IP address of the server",
+						},
+						"device_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name of the device the address belongs to, for redundant BIG-IP servers",
+						},
+						"translation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "none",
+							Description: "Public address the server address is translated to, or `none`",
+						},
+					},
+				},
+			},
+			"virtual_server": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Virtual servers of the server, used when `virtual_server_discovery` is `disabled`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the virtual server, for BIG-IP servers the full path of the LTM virtual server",
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Address and port of the virtual server, for example `10.1.1.10:80`",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User defined description",
+						},
+						"translation_address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Public address the virtual server is translated to",
+						},
+						"translation_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+							Description:  "Public port the virtual server is translated to",
+						},
+						"monitor": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Health monitors of the virtual server",
+						},
+						"explicit_link_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Link the virtual server is reached through",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipGtmServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating GTM Server:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmServerConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmServer)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmServerRead(ctx, d, meta)
+}
+
+func resourceBigipGtmServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching GTM Server " + name)
+
+	var server gtmServer
+	found, err := getTmEntity(client, &server, uriGtmServer, tmPath(name)+"?expandSubcollections=true")
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Server (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", server.FullPath)
+	_ = d.Set("description", server.Description)
+	_ = d.Set("datacenter", server.Datacenter)
+	_ = d.Set("product", server.Product)
+	_ = d.Set("monitor", server.Monitor)
+	_ = d.Set("virtual_server_discovery", server.VirtualServerDiscovery)
+	_ = d.Set("link_discovery", server.LinkDiscovery)
+	_ = d.Set("prober_preference", server.ProberPreference)
+	_ = d.Set("prober_fallback", server.ProberFallback)
+	_ = d.Set("enabled", !server.Disabled)
+
+	var addresses []interface{}
+	for _, a := range server.Addresses {
+		addresses = append(addresses, map[string]interface{}{
+			"name":        a.Name,
+			"device_name": a.DeviceName,
+			"translation": a.Translation,
+		})
+	}
+	if err := d.Set("address", addresses); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving address to state for GTM Server (%s): %s", name, err))
+	}
+
+	// Discovered virtual servers are owned by the BIG-IP, so they only end up in
+	// state when they are listed explicitly.
+	if server.VirtualServerDiscovery == "disabled" || server.VirtualServerDiscovery == "" {
+		var virtualServers []interface{}
+		if server.VirtualServersReference != nil {
+			for _, vs := range server.VirtualServersReference.Items {
+				virtualServers = append(virtualServers, map[string]interface{}{
+					"name":                vs.Name,
+					"destination":         vs.Destination,
+					"description":         vs.Description,
+					"translation_address": vs.TranslationAddress,
+					"translation_port":    vs.TranslationPort,
+					"monitor":             vs.Monitor,
+					"explicit_link_name":  vs.ExplicitLinkName,
+				})
+			}
+		}
+		if err := d.Set("virtual_server", virtualServers); err != nil {
+			return diag.FromErr(fmt.Errorf("[DEBUG] Error saving virtual_server to state for GTM Server (%s): %s", name, err))
+		}
+	}
+	return nil
+}
+
+func resourceBigipGtmServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Server:%+v ", name)
+
+	err := patchTmEntity(client, getGtmServerConfig(d), uriGtmServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmServerRead(ctx, d, meta)
+}
+
+func resourceBigipGtmServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Server " + name)
+
+	err := client.DeleteGtmserver(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmServerConfig(d *schema.ResourceData) *gtmServer {
+	config := &gtmServer{
+		Description:            d.Get("description").(string),
+		Datacenter:             d.Get("datacenter").(string),
+		Product:                d.Get("product").(string),
+		Monitor:                d.Get("monitor").(string),
+		VirtualServerDiscovery: d.Get("virtual_server_discovery").(string),
+		LinkDiscovery:          d.Get("link_discovery").(string),
+		ProberPreference:       d.Get("prober_preference").(string),
+		ProberFallback:         d.Get("prober_fallback").(string),
+	}
+	if d.Get("enabled").(bool) {
+		config.Enabled = true
+	} else {
+		config.Disabled = true
+	}
+	for _, item := range d.Get("address").([]interface{}) {
+		a := item.(map[string]interface{})
+		config.Addresses = append(config.Addresses, gtmServerAddress{
+			Name:        a["name"].(string),
+			DeviceName:  a["device_name"].(string),
+			Translation: a["translation"].(string),
+		})
+	}
+	// With discovery disabled the list is always sent, so removing the last
+	// virtual_server block removes it from the server as well.
+	virtualServers := []gtmServerVirtualServer{}
+	for _, item := range d.Get("virtual_server").([]interface{}) {
+		vs := item.(map[string]interface{})
+		virtualServers = append(virtualServers, gtmServerVirtualServer{
+			Name:               vs["name"].(string),
+			Destination:        vs["destination"].(string),
+			Description:        vs["description"].(string),
+			TranslationAddress: vs["translation_address"].(string),
+			TranslationPort:    vs["translation_port"].(int),
+			Monitor:            vs["monitor"].(string),
+			ExplicitLinkName:   vs["explicit_link_name"].(string),
+		})
+	}
+	if config.VirtualServerDiscovery == "disabled" || len(virtualServers) > 0 {
+		config.VirtualServers = &virtualServers
+	}
+	return config
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestGtmServerName = "/Common/test-gtm-server"
+
+func TestAccBigipGtmServerCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_server", uriGtmServer),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmServerResource("203.0.113.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmServer, TestGtmServerName),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "name", TestGtmServerName),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "product", "generic-host"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "address.#", "1"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "address.0.translation", "203.0.113.10"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "virtual_server.#", "2"),
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "virtual_server.0.destination", "10.1.1.10:80"),
+				),
+			},
+			{
+				Config: testGtmServerResource("203.0.113.20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_server.test-server", "address.0.translation", "203.0.113.20"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_server.test-server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGtmServerResource(translation string) string {
+	return fmt.Sprintf(`
+resource "bigip_gtm_datacenter" "test-dc" {
+  name = "%s"
+}
+
+resource "bigip_gtm_server" "test-server" {
+  name       = "%s"
+  datacenter = bigip_gtm_datacenter.test-dc.name
+  product    = "generic-host"
+  monitor    = "/Common/gateway_icmp"
+  address {
+    name        = "10.1.1.1"
+    translation = "%s"
+  }
+  virtual_server {
+    name        = "web"
+    destination = "10.1.1.10:80"
+  }
+  virtual_server {
+    name        = "web-tls"
+    destination = "10.1.1.10:443"
+  }
+}
+`, TestGtmDatacenterName, TestGtmServerName, translation)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmTopology = "gtm/topology"
+
+// A topology record has no name of its own, BIG-IP names it after the two
+// matches, e.g. "ldns: country DE server: datacenter /Common/fra".
+type gtmTopologyRecord struct {
+	Name  string `json:"name,omitempty"`
+	Score int    `json:"score"`
+}
+
+func resourceBigipGtmTopologyRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmTopologyRecordCreate,
+		ReadContext:   resourceBigipGtmTopologyRecordRead,
+		UpdateContext: resourceBigipGtmTopologyRecordUpdate,
+		DeleteContext: resourceBigipGtmTopologyRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"source":      gtmTopologyMatchSchema(gtmTopologySourceTypes, "Match on the local DNS server (LDNS) that sent the request"),
+			"destination": gtmTopologyMatchSchema(gtmTopologyMatchTypes, "Match on the pool member, or the datacenter or pool it belongs to"),
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Score added to a destination when the record matches, the destination with the highest total score is chosen",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name BIG-IP gives the record, built from source and destination",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmTopologyRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := getGtmTopologyRecordName(d)
+	log.Printf("[INFO] Creating GTM Topology Record:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := &gtmTopologyRecord{
+		Name:  name,
+		Score: d.Get("weight").(int),
+	}
+	err := createTmEntity(client, config, uriGtmTopology)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Topology Record (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmTopologyRecordRead(ctx, d, meta)
+}
+
+func resourceBigipGtmTopologyRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching GTM Topology Record " + name)
+
+	var record gtmTopologyRecord
+	found, err := getTmEntity(client, &record, uriGtmTopology, gtmTopologyRecordPath(name))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Topology Record (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Topology Record (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	source, destination, err := parseGtmTopologyRecordName(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("source", []interface{}{source})
+	_ = d.Set("destination", []interface{}{destination})
+	_ = d.Set("weight", record.Score)
+	return nil
+}
+
+func resourceBigipGtmTopologyRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Topology Record:%+v ", name)
+
+	err := patchTmEntity(client, &gtmTopologyRecord{Score: d.Get("weight").(int)}, uriGtmTopology, gtmTopologyRecordPath(name))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Topology Record (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmTopologyRecordRead(ctx, d, meta)
+}
+
+func resourceBigipGtmTopologyRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Topology Record " + name)
+
+	err := deleteTmEntity(client, uriGtmTopology, gtmTopologyRecordPath(name))
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Topology Record (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getGtmTopologyRecordName(d *schema.ResourceData) string {
+	source := gtmTopologyMatchFromMap(d.Get("source").([]interface{})[0].(map[string]interface{}))
+	destination := gtmTopologyMatchFromMap(d.Get("destination").([]interface{})[0].(map[string]interface{}))
+	return fmt.Sprintf("ldns: %s server: %s", source, destination)
+}
+
+// Record names contain spaces and the paths of the matched objects, so they
+// are escaped by hand instead of going through tmPath.
+func gtmTopologyRecordPath(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, " ", "%20"), "/", "~")
+}
+
+func parseGtmTopologyRecordName(name string) (map[string]interface{}, map[string]interface{}, error) {
+	source, destination, ok := strings.Cut(strings.TrimPrefix(name, "ldns: "), " server: ")
+	if !ok || !strings.HasPrefix(name, "ldns: ") {
+		return nil, nil, fmt.Errorf("invalid GTM topology record %q, expected ldns: <match> server: <match>", name)
+	}
+	var matches []map[string]interface{}
+	for _, match := range []string{source, destination} {
+		matchType, value, negate, err := parseGtmTopologyMatch(match)
+		if err != nil {
+			return nil, nil, err
+		}
+		matches = append(matches, map[string]interface{}{
+			"type":   matchType,
+			"value":  value,
+			"negate": negate,
+		})
+	}
+	return matches[0], matches[1], nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestGtmTopologyRecordName = "ldns: region " + TestGtmRegionName + " server: datacenter " + TestGtmDatacenterName
+
+func TestAccBigipGtmTopologyRecordCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmTopologyRecordsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmTopologyRecordResource(100),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmTopology, gtmTopologyRecordPath(TestGtmTopologyRecordName)),
+					resource.TestCheckResourceAttr("bigip_gtm_topology_record.test-record", "name", TestGtmTopologyRecordName),
+					resource.TestCheckResourceAttr("bigip_gtm_topology_record.test-record", "weight", "100"),
+				),
+			},
+			{
+				Config: testGtmTopologyRecordResource(50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_topology_record.test-record", "weight", "50"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_topology_record.test-record",
+				ImportStateId:     TestGtmTopologyRecordName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckGtmTopologyRecordsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_gtm_topology_record" {
+			continue
+		}
+		var record gtmTopologyRecord
+		found, err := getTmEntity(client, &record, uriGtmTopology, gtmTopologyRecordPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("GTM topology record %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testGtmTopologyRecordResource(weight int) string {
+	return testGtmDatacenterResource(TestGtmDatacenterName, "Frankfurt", true) + testGtmRegionResource("DE") + fmt.Sprintf(`
+resource "bigip_gtm_topology_record" "test-record" {
+  source {
+    type  = "region"
+    value = bigip_gtm_region.test-region.name
+  }
+  destination {
+    type  = "datacenter"
+    value = bigip_gtm_datacenter.test-dc.name
+  }
+  weight = %d
+}
+`, weight)
+}
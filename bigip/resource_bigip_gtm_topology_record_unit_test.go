@@ -0,0 +1,29 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGtmTopologyRecordName(t *testing.T) {
+	name := "ldns: not country DE server: datacenter /Common/fra"
+	source, destination, err := parseGtmTopologyRecordName(name)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"type": "country", "value": "DE", "negate": true}, source)
+	assert.Equal(t, map[string]interface{}{"type": "datacenter", "value": "/Common/fra", "negate": false}, destination)
+	assert.Equal(t, name, "ldns: "+gtmTopologyMatchFromMap(source)+" server: "+gtmTopologyMatchFromMap(destination))
+
+	_, _, err = parseGtmTopologyRecordName("country DE server: pool /Common/web")
+	assert.Error(t, err)
+}
+
+func TestGtmTopologyRecordPath(t *testing.T) {
+	assert.Equal(t, "ldns:%20subnet%2010.0.0.0~8%20server:%20pool%20~Common~web",
+		gtmTopologyRecordPath("ldns: subnet 10.0.0.0/8 server: pool /Common/web"))
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriGtmWideip = "gtm/wideip"
+
+type gtmWideip struct {
+	Name            string          `json:"name,omitempty"`
+	FullPath        string          `json:"fullPath,omitempty"`
+	Description     string          `json:"description"`
+	PoolLbMode      string          `json:"poolLbMode,omitempty"`
+	Persistence     string          `json:"persistence,omitempty"`
+	TtlPersistence  int             `json:"ttlPersistence,omitempty"`
+	PersistCidrIpv4 int             `json:"persistCidrIpv4,omitempty"`
+	PersistCidrIpv6 int             `json:"persistCidrIpv6,omitempty"`
+	LastResortPool  string          `json:"lastResortPool"`
+	MinimalResponse string          `json:"minimalResponse,omitempty"`
+	Enabled         bool            `json:"enabled,omitempty"`
+	Disabled        bool            `json:"disabled,omitempty"`
+	Aliases         []string        `json:"aliases"`
+	Rules           []string        `json:"rules"`
+	Pools           []gtmWideipPool `json:"pools"`
+}
+
+type gtmWideipPool struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	Order     int    `json:"order"`
+	Ratio     int    `json:"ratio,omitempty"`
+}
+
+func resourceBigipGtmWideip() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipGtmWideipCreate,
+		ReadContext:   resourceBigipGtmWideipRead,
+		UpdateContext: resourceBigipGtmWideipUpdate,
+		DeleteContext: resourceBigipGtmWideipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBigipGtmWideipImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the Wide IP, the fully qualified domain name in full path format, e.g. /Common/www.example.com",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(gtmRecordTypes, false),
+				Description:  "DNS record type served by the Wide IP",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"pool_lb_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "round-robin",
+				ValidateFunc: validation.StringInSlice([]string{"global-availability", "ratio", "round-robin", "topology"}, false),
+				Description:  "Load balancing mode used to pick a pool",
+			},
+			"pool": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "GTM pools of the Wide IP",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "GTM pool of the same type as the Wide IP",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Order of the pool for `global-availability`, defaults to the position in the list",
+						},
+						"ratio": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1,
+							Description: "Weight of the pool for the `ratio` load balancing mode",
+						},
+					},
+				},
+			},
+			"persistence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "disabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Return the same answer to a client (LDNS) for `ttl_persistence` seconds",
+			},
+			"ttl_persistence": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds a persistence entry is kept",
+			},
+			"persist_cidr_ipv4": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+				Description:  "Prefix length of the IPv4 client addresses sharing a persistence entry",
+			},
+			"persist_cidr_ipv6": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 128),
+				Description:  "Prefix length of the IPv6 client addresses sharing a persistence entry",
+			},
+			"last_resort_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Pool used when all other pools are unavailable, of the same type as the Wide IP",
+			},
+			"minimal_response": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Omit the authority and additional sections from answers",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables the Wide IP",
+			},
+			"aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Alternate domain names, wildcards such as `*.example.com` are allowed",
+			},
+			"irules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "GTM iRules of the Wide IP",
+			},
+		},
+	}
+}
+
+func resourceBigipGtmWideipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	wideipType := d.Get("type").(string)
+	log.Printf("[INFO] Creating GTM Wide IP:%+v ", name)
+
+	if err := checkModuleProvisioned(client, "gtm"); err != nil {
+		return diag.FromErr(err)
+	}
+	config := getGtmWideipConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriGtmWideip, wideipType)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create GTM Wide IP (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipGtmWideipRead(ctx, d, meta)
+}
+
+func resourceBigipGtmWideipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	wideipType := d.Get("type").(string)
+	log.Println("[INFO] Fetching GTM Wide IP " + name)
+
+	var wideip gtmWideip
+	found, err := getTmEntity(client, &wideip, uriGtmWideip, wideipType, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve GTM Wide IP (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] GTM Wide IP (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", wideip.FullPath)
+	_ = d.Set("description", wideip.Description)
+	_ = d.Set("pool_lb_mode", wideip.PoolLbMode)
+	_ = d.Set("persistence", wideip.Persistence)
+	_ = d.Set("ttl_persistence", wideip.TtlPersistence)
+	_ = d.Set("persist_cidr_ipv4", wideip.PersistCidrIpv4)
+	_ = d.Set("persist_cidr_ipv6", wideip.PersistCidrIpv6)
+	_ = d.Set("minimal_response", wideip.MinimalResponse)
+	_ = d.Set("enabled", !wideip.Disabled)
+	_ = d.Set("aliases", wideip.Aliases)
+	_ = d.Set("irules", wideip.Rules)
+	// BIG-IP prefixes the last resort pool with its type, e.g. "a /Common/pool".
+	lastResortPool := strings.TrimPrefix(wideip.LastResortPool, wideipType+" ")
+	if lastResortPool == "none" {
+		lastResortPool = ""
+	}
+	_ = d.Set("last_resort_pool", lastResortPool)
+
+	var pools []interface{}
+	for _, p := range wideip.Pools {
+		poolName := p.Name
+		if p.Partition != "" && !strings.HasPrefix(poolName, "/") {
+			poolName = fmt.Sprintf("/%s/%s", p.Partition, p.Name)
+		}
+		pools = append(pools, map[string]interface{}{
+			"name":  poolName,
+			"order": p.Order,
+			"ratio": p.Ratio,
+		})
+	}
+	if err := d.Set("pool", pools); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving pool to state for GTM Wide IP (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipGtmWideipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating GTM Wide IP:%+v ", name)
+
+	err := patchTmEntity(client, getGtmWideipConfig(d), uriGtmWideip, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify GTM Wide IP (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipGtmWideipRead(ctx, d, meta)
+}
+
+func resourceBigipGtmWideipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting GTM Wide IP " + name)
+
+	err := deleteTmEntity(client, uriGtmWideip, d.Get("type").(string), name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete GTM Wide IP (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// The import ID is the record type followed by the Wide IP path, e.g. a:/Common/www.example.com.
+func resourceBigipGtmWideipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	recordType, name, err := parseGtmImportID(d.Id(), gtmRecordTypes)
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("type", recordType)
+	d.SetId(name)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getGtmWideipConfig(d *schema.ResourceData) *gtmWideip {
+	config := &gtmWideip{
+		Description:     d.Get("description").(string),
+		PoolLbMode:      d.Get("pool_lb_mode").(string),
+		Persistence:     d.Get("persistence").(string),
+		TtlPersistence:  d.Get("ttl_persistence").(int),
+		PersistCidrIpv4: d.Get("persist_cidr_ipv4").(int),
+		PersistCidrIpv6: d.Get("persist_cidr_ipv6").(int),
+		MinimalResponse: d.Get("minimal_response").(string),
+		Aliases:         setToStringSlice(d.Get("aliases").(*schema.Set)),
+		Rules:           listToStringSlice(d.Get("irules").([]interface{})),
+		Pools:           []gtmWideipPool{},
+		LastResortPool:  "none",
+	}
+	if pool := d.Get("last_resort_pool").(string); pool != "" {
+		config.LastResortPool = d.Get("type").(string) + " " + pool
+	}
+	if config.Aliases == nil {
+		config.Aliases = []string{}
+	}
+	if config.Rules == nil {
+		config.Rules = []string{}
+	}
+	if d.Get("enabled").(bool) {
+		config.Enabled = true
+	} else {
+		config.Disabled = true
+	}
+	for i, item := range d.Get("pool").([]interface{}) {
+		p := item.(map[string]interface{})
+		order := p["order"].(int)
+		if order == 0 {
+			order = i
+		}
+		config.Pools = append(config.Pools, gtmWideipPool{
+			Name:  p["name"].(string),
+			Order: order,
+			Ratio: p["ratio"].(int),
+		})
+	}
+	return config
+}
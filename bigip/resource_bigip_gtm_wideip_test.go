@@ -0,0 +1,65 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestGtmWideipName = "/Common/test.example.com"
+
+func TestAccBigipGtmWideipCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckGtmEntitiesDestroyed("bigip_gtm_wideip", uriGtmWideip+"/a"),
+		Steps: []resource.TestStep{
+			{
+				Config: testGtmWideipResource("disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckGtmEntityExists(uriGtmWideip+"/a", TestGtmWideipName),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "name", TestGtmWideipName),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pool.#", "1"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "pool.0.name", TestGtmPoolName),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persistence", "disabled"),
+				),
+			},
+			{
+				Config: testGtmWideipResource("enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_gtm_wideip.test-wideip", "persistence", "enabled"),
+				),
+			},
+			{
+				ResourceName:      "bigip_gtm_wideip.test-wideip",
+				ImportStateId:     "a:" + TestGtmWideipName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGtmWideipResource(persistence string) string {
+	return testGtmPoolResource("round-robin", "web") + fmt.Sprintf(`
+resource "bigip_gtm_wideip" "test-wideip" {
+  name         = "%s"
+  type         = "a"
+  pool_lb_mode = "global-availability"
+  persistence  = "%s"
+  aliases      = ["alias.example.com"]
+  pool {
+    name = bigip_gtm_pool.test-pool.name
+  }
+}
+`, TestGtmWideipName, persistence)
+}
@@ -11,8 +11,8 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"regexp"
 	"strings"
+	"sync"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -169,47 +169,14 @@ func resourceBigipLtmDataGroupUpdate(ctx context.Context, d *schema.ResourceData
 	name := d.Id()
 	log.Printf("[DEBUG] Modifying Data Group List %s", name)
 
-	rs := d.Get("record").(*schema.Set)
 	dgtype := d.Get("type").(string)
 
 	if d.Get("internal").(bool) {
-		var records []bigip.DataGroupRecord
-		if rs.Len() > 0 {
-			for _, r := range rs.List() {
-				record := r.(map[string]interface{})
-				records = append(records, bigip.DataGroupRecord{Name: record["name"].(string), Data: record["data"].(string)})
-			}
-		} else {
-			records = nil
-		}
-
-		dgver := &bigip.DataGroup{
-			Name:    name,
-			Type:    dgtype,
-			Records: records,
-		}
-		dgver1213 := &bigip.DataGroup{
-			Name:    name,
-			Records: records,
-		}
-
-		ver, err := client.BigipVersion()
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("Could not get BigipVersion: %v ", err))
-		}
-
-		bigipversion := ver.Entries.HTTPSLocalhostMgmtTmCliVersion0.NestedStats.Entries.Active.Description
-		re := regexp.MustCompile(`^(12)|(13).*`)
-		matchresult := re.MatchString(bigipversion)
-		regversion := re.FindAllString(bigipversion, -1)
-		if matchresult {
-			log.Printf("[DEBUG] Bigip version is : %s", regversion)
-			if err := client.ModifyInternalDataGroupRecords(dgver1213); err != nil {
-				return diag.FromErr(fmt.Errorf("Error modifying Data Group List %s: %v ", name, err))
-			}
-		} else {
-			log.Printf("[DEBUG] Bigip version is : %s", regversion)
-			if err := client.ModifyInternalDataGroupRecords(dgver); err != nil {
+		if d.HasChange("record") {
+			o, n := d.GetChange("record")
+			add, change, remove := diffDataGroupRecords(getDataGroupRecords(o.(*schema.Set)), getDataGroupRecords(n.(*schema.Set)))
+			log.Printf("[DEBUG] Data Group List %s: adding %d, changing %d and deleting %d records", name, len(add), len(change), len(remove))
+			if err := modifyDataGroupRecords(client, name, add, change, remove); err != nil {
 				return diag.FromErr(fmt.Errorf("Error modifying Data Group List %s: %v ", name, err))
 			}
 		}
@@ -253,3 +220,103 @@ func resourceBigipLtmDataGroupDelete(ctx context.Context, d *schema.ResourceData
 	d.SetId("")
 	return nil
 }
+
+func getDataGroupRecords(rs *schema.Set) []bigip.DataGroupRecord {
+	var records []bigip.DataGroupRecord
+	for _, r := range rs.List() {
+		record := r.(map[string]interface{})
+		records = append(records, bigip.DataGroupRecord{Name: record["name"].(string), Data: record["data"].(string)})
+	}
+	return records
+}
+
+// diffDataGroupRecords compares two record sets and returns the records with
+// new keys, the records whose data changed and the keys that went away.
+func diffDataGroupRecords(old, new []bigip.DataGroupRecord) (add, change []bigip.DataGroupRecord, remove []string) {
+	current := make(map[string]string, len(old))
+	for _, r := range old {
+		current[r.Name] = r.Data
+	}
+	wanted := make(map[string]bool, len(new))
+	for _, r := range new {
+		wanted[r.Name] = true
+		data, ok := current[r.Name]
+		if !ok {
+			add = append(add, r)
+		} else if data != r.Data {
+			change = append(change, r)
+		}
+	}
+	for _, r := range old {
+		if !wanted[r.Name] {
+			remove = append(remove, r.Name)
+		}
+	}
+	return add, change, remove
+}
+
+// dataGroupRecordsMutex serializes the record changes, which read the records
+// of the data group and write them back, so parallel bigip_ltm_datagroup_record
+// resources of one data group do not overwrite each other.
+var dataGroupRecordsMutex sync.Mutex
+
+// modifyDataGroupRecords applies record level changes to the records currently
+// on the internal data group, so records managed elsewhere are kept.
+func modifyDataGroupRecords(client *bigip.BigIP, name string, add, change []bigip.DataGroupRecord, remove []string) error {
+	dataGroupRecordsMutex.Lock()
+	defer dataGroupRecordsMutex.Unlock()
+
+	datagroup, err := client.GetInternalDataGroup(name)
+	if err != nil {
+		return err
+	}
+	if datagroup == nil {
+		return fmt.Errorf("data group %s does not exist", name)
+	}
+	records := applyDataGroupRecordChanges(datagroup.Records, add, change, remove)
+	if len(records) == 0 {
+		// go-bigip leaves out an empty record list, which would keep the records.
+		return patchTmEntity(client, &dataGroupNoRecords{Records: []bigip.DataGroupRecord{}}, "ltm/data-group/internal", name)
+	}
+	return client.ModifyInternalDataGroupRecords(&bigip.DataGroup{Name: name, Records: records})
+}
+
+type dataGroupNoRecords struct {
+	Records []bigip.DataGroupRecord `json:"records"`
+}
+
+// applyDataGroupRecordChanges returns records with the removed keys dropped,
+// the changed records updated in place and the added records appended.
+func applyDataGroupRecordChanges(records, add, change []bigip.DataGroupRecord, remove []string) []bigip.DataGroupRecord {
+	dropped := make(map[string]bool, len(remove)+len(add))
+	for _, key := range remove {
+		dropped[key] = true
+	}
+	// A key added again replaces a record that is still on the device.
+	for _, r := range add {
+		dropped[r.Name] = true
+	}
+	changed := make(map[string]string, len(change))
+	for _, r := range change {
+		changed[r.Name] = r.Data
+	}
+	result := make([]bigip.DataGroupRecord, 0, len(records)+len(add))
+	for _, r := range records {
+		if dropped[r.Name] {
+			continue
+		}
+		if data, ok := changed[r.Name]; ok {
+			r.Data = data
+			delete(changed, r.Name)
+		}
+		result = append(result, r)
+	}
+	result = append(result, add...)
+	// Changed keys that were removed on the device meanwhile are added back.
+	for _, r := range change {
+		if _, ok := changed[r.Name]; ok {
+			result = append(result, r)
+		}
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The data group file is uploaded and registered as a sys file data-group with
+// the same name as the data group, which then references it.
+func resourceBigipLtmDataGroupExternal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmDataGroupExternalCreate,
+		ReadContext:   resourceBigipLtmDataGroupExternalRead,
+		UpdateContext: resourceBigipLtmDataGroupExternalUpdate,
+		DeleteContext: resourceBigipLtmDataGroupExternalDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the External Data Group List",
+				ValidateFunc: validateF5Name,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The Data Group type (string, ip, integer)",
+				ValidateFunc: validateDataGroupType,
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local data group file to upload",
+			},
+			"content_hash": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Hash of the data group file, usually `filesha256(source)`. The file is uploaded again when it changes",
+			},
+			"external_file_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data group file object referenced by the data group",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDataGroupExternalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[DEBUG] Creating External Data Group List %s", name)
+
+	if err := uploadExternalDataGroup(client, name, d.Get("type").(string), d.Get("source").(string), true); err != nil {
+		return diag.FromErr(fmt.Errorf("error in creating External Datagroup (%s): %s", name, err))
+	}
+	d.SetId(name)
+	return resourceBigipLtmDataGroupExternalRead(ctx, d, meta)
+}
+
+func resourceBigipLtmDataGroupExternalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[DEBUG] Retrieving External Data Group List %s", name)
+
+	datagroup, err := client.GetExternalDataGroup(name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving External Data Group List %s: %v", name, err))
+	}
+	if datagroup == nil {
+		log.Printf("[DEBUG] External Data Group List %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", datagroup.FullPath)
+	_ = d.Set("type", datagroup.Type)
+	_ = d.Set("external_file_name", datagroup.ExternalFileName)
+	return nil
+}
+
+func resourceBigipLtmDataGroupExternalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[DEBUG] Modifying External Data Group List %s", name)
+
+	if d.HasChanges("source", "content_hash") {
+		if err := uploadExternalDataGroup(client, name, d.Get("type").(string), d.Get("source").(string), false); err != nil {
+			return diag.FromErr(fmt.Errorf("error in modifying External Datagroup (%s): %s", name, err))
+		}
+	}
+	return resourceBigipLtmDataGroupExternalRead(ctx, d, meta)
+}
+
+func resourceBigipLtmDataGroupExternalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[DEBUG] Deleting External Data Group List %s", name)
+
+	err := client.DeleteExternalDataGroup(name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error deleting External Data Group List %s: %v ", name, err))
+	}
+	fileName := d.Get("external_file_name").(string)
+	if fileName == "" {
+		fileName = name
+	}
+	err = client.DeleteExternalDatagroupfile(fileName)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete External Datagroup file   (%s) (%v) ", fileName, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// uploadExternalDataGroup uploads the local file and creates (or reloads) the
+// data group file object and the external data group that references it.
+func uploadExternalDataGroup(client *bigip.BigIP, name, dgtype, source string, create bool) error {
+	res := strings.Split(name, "/")
+	file, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("error in reading file: %s", err)
+	}
+	defer file.Close()
+	return client.UploadDatagroup(file, res[2], res[1], dgtype, create)
+}
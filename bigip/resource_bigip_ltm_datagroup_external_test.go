@@ -0,0 +1,87 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestExternalDatagroupName = "/" + TestPartition + "/test-datagroup-external"
+
+func TestAccBigipLtmDataGroupExternal_create(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "redirects.txt")
+	writeSource := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(source, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+			writeSource("\"/old\" := \"/new\",\n")()
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckExternalDataGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getExternalDataGroupConfig(TestExternalDatagroupName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckExternalDataGroupExists(TestExternalDatagroupName),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup_external.test-datagroup", "name", TestExternalDatagroupName),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup_external.test-datagroup", "type", "string"),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup_external.test-datagroup", "external_file_name", TestExternalDatagroupName),
+				),
+			},
+			{
+				PreConfig: writeSource("\"/old\" := \"/new\",\n\"/legacy\" := \"/current\",\n"),
+				Config:    getExternalDataGroupConfig(TestExternalDatagroupName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckExternalDataGroupExists(TestExternalDatagroupName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckExternalDataGroupDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_datagroup_external" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		datagroup, err := client.GetExternalDataGroup(name)
+		if err != nil {
+			return err
+		}
+		if datagroup != nil {
+			return fmt.Errorf("External Data Group %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getExternalDataGroupConfig(name, source string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_datagroup_external" "test-datagroup" {
+  name         = "%[1]s"
+  type         = "string"
+  source       = "%[2]s"
+  content_hash = filesha256("%[2]s")
+}
+`, name, source)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A single record of an internal data group, so that different configurations
+// can own different keys of the same data group. The ID is the data group
+// path followed by the record name, e.g. /Common/dg/10.0.0.0/8.
+func resourceBigipLtmDataGroupRecord() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmDataGroupRecordCreate,
+		ReadContext:   resourceBigipLtmDataGroupRecordRead,
+		UpdateContext: resourceBigipLtmDataGroupRecordUpdate,
+		DeleteContext: resourceBigipLtmDataGroupRecordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"datagroup": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Internal Data Group List the record belongs to",
+				ValidateFunc: validateF5Name,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key of the record",
+			},
+			"data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the record",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmDataGroupRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	datagroup := d.Get("datagroup").(string)
+	record := bigip.DataGroupRecord{
+		Name: d.Get("name").(string),
+		Data: d.Get("data").(string),
+	}
+	log.Printf("[DEBUG] Adding record %s to Data Group List %s", record.Name, datagroup)
+
+	if err := modifyDataGroupRecords(client, datagroup, []bigip.DataGroupRecord{record}, nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("Error adding record %s to Data Group List %s: %v ", record.Name, datagroup, err))
+	}
+	d.SetId(datagroup + "/" + record.Name)
+	return resourceBigipLtmDataGroupRecordRead(ctx, d, meta)
+}
+
+func resourceBigipLtmDataGroupRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	datagroup, name, err := parseDataGroupRecordID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Retrieving record %s of Data Group List %s", name, datagroup)
+
+	dg, err := client.GetInternalDataGroup(datagroup)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error retrieving Data Group List %s: %v ", datagroup, err))
+	}
+	if dg != nil {
+		for _, record := range dg.Records {
+			if record.Name == name {
+				_ = d.Set("datagroup", datagroup)
+				_ = d.Set("name", record.Name)
+				_ = d.Set("data", record.Data)
+				return nil
+			}
+		}
+	}
+	log.Printf("[DEBUG] Record %s of Data Group List %s not found, removing from state", name, datagroup)
+	d.SetId("")
+	return nil
+}
+
+func resourceBigipLtmDataGroupRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	datagroup := d.Get("datagroup").(string)
+	record := bigip.DataGroupRecord{
+		Name: d.Get("name").(string),
+		Data: d.Get("data").(string),
+	}
+	log.Printf("[DEBUG] Modifying record %s of Data Group List %s", record.Name, datagroup)
+
+	if err := modifyDataGroupRecords(client, datagroup, nil, []bigip.DataGroupRecord{record}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("Error modifying record %s of Data Group List %s: %v ", record.Name, datagroup, err))
+	}
+	return resourceBigipLtmDataGroupRecordRead(ctx, d, meta)
+}
+
+func resourceBigipLtmDataGroupRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	datagroup, name, err := parseDataGroupRecordID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Deleting record %s of Data Group List %s", name, datagroup)
+
+	if err := modifyDataGroupRecords(client, datagroup, nil, nil, []string{name}); err != nil {
+		return diag.FromErr(fmt.Errorf("Error deleting record %s of Data Group List %s: %v ", name, datagroup, err))
+	}
+	d.SetId("")
+	return nil
+}
+
+func parseDataGroupRecordID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) != 4 || parts[0] != "" || parts[3] == "" {
+		return "", "", fmt.Errorf("invalid data group record id %q, expected /Partition/DataGroup/RecordName", id)
+	}
+	return "/" + parts[1] + "/" + parts[2], parts[3], nil
+}
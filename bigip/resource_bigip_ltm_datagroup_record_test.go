@@ -0,0 +1,91 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestDatagroupRecordDG = "/" + TestPartition + "/test-datagroup-records"
+
+func TestAccBigipLtmDataGroupRecord_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckDataGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getDataGroupRecordConfig("blocked"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDataGroupRecord(TestDatagroupRecordDG, "10.1.1.0/24", "blocked"),
+					resource.TestCheckResourceAttr("bigip_ltm_datagroup_record.test-record", "id", TestDatagroupRecordDG+"/10.1.1.0/24"),
+				),
+			},
+			{
+				Config: getDataGroupRecordConfig("allowed"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDataGroupRecord(TestDatagroupRecordDG, "10.1.1.0/24", "allowed"),
+				),
+			},
+			{
+				ResourceName:      "bigip_ltm_datagroup_record.test-record",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckDataGroupRecord(datagroup, name, data string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		dg, err := client.GetInternalDataGroup(datagroup)
+		if err != nil {
+			return err
+		}
+		if dg == nil {
+			return fmt.Errorf("Data Group %s not found ", datagroup)
+		}
+		for _, record := range dg.Records {
+			if record.Name == name {
+				if record.Data != data {
+					return fmt.Errorf("record %s of %s has data %q, expected %q ", name, datagroup, record.Data, data)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("record %s not found in Data Group %s ", name, datagroup)
+	}
+}
+
+func getDataGroupRecordConfig(data string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_datagroup" "test-datagroup" {
+  name = "%[1]s"
+  type = "ip"
+  record {
+    name = "192.168.0.0/16"
+    data = "internal"
+  }
+  lifecycle {
+    ignore_changes = [record]
+  }
+}
+
+resource "bigip_ltm_datagroup_record" "test-record" {
+  datagroup = bigip_ltm_datagroup.test-datagroup.name
+  name      = "10.1.1.0/24"
+  data      = "%[2]s"
+}
+`, TestDatagroupRecordDG, data)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffDataGroupRecords(t *testing.T) {
+	old := []bigip.DataGroupRecord{
+		{Name: "a", Data: "1"},
+		{Name: "b", Data: "2"},
+		{Name: "c"},
+	}
+	new := []bigip.DataGroupRecord{
+		{Name: "a", Data: "1"},
+		{Name: "b", Data: "20"},
+		{Name: "d", Data: "4"},
+	}
+	add, change, remove := diffDataGroupRecords(old, new)
+	assert.Equal(t, []bigip.DataGroupRecord{{Name: "d", Data: "4"}}, add)
+	assert.Equal(t, []bigip.DataGroupRecord{{Name: "b", Data: "20"}}, change)
+	assert.Equal(t, []string{"c"}, remove)
+}
+
+func TestApplyDataGroupRecordChanges(t *testing.T) {
+	records := []bigip.DataGroupRecord{
+		{Name: "a", Data: "1"},
+		{Name: "b", Data: "2"},
+		{Name: "c"},
+		{Name: "other", Data: "managed elsewhere"},
+	}
+	result := applyDataGroupRecordChanges(records,
+		[]bigip.DataGroupRecord{{Name: "d", Data: "4"}, {Name: "a", Data: "10"}},
+		[]bigip.DataGroupRecord{{Name: "b", Data: "20"}, {Name: "e", Data: "5"}},
+		[]string{"c"},
+	)
+	assert.Equal(t, []bigip.DataGroupRecord{
+		{Name: "b", Data: "20"},
+		{Name: "other", Data: "managed elsewhere"},
+		{Name: "d", Data: "4"},
+		{Name: "a", Data: "10"},
+		{Name: "e", Data: "5"},
+	}, result)
+}
+
+func TestParseDataGroupRecordID(t *testing.T) {
+	datagroup, name, err := parseDataGroupRecordID("/Common/blocklist/10.0.0.0/8")
+	assert.NoError(t, err)
+	assert.Equal(t, "/Common/blocklist", datagroup)
+	assert.Equal(t, "10.0.0.0/8", name)
+
+	_, _, err = parseDataGroupRecordID("/Common/blocklist")
+	assert.Error(t, err)
+}
+
+func TestModifyDataGroupRecords(t *testing.T) {
+	setup()
+	defer teardown()
+	long := strings.Repeat(`say "hi" to http://example.com/a b:c `, 200)
+	var records []bigip.DataGroupRecord
+	for i := 0; i < 50; i++ {
+		records = append(records, bigip.DataGroupRecord{Name: fmt.Sprintf("10.0.%d.0/24", i), Data: long})
+	}
+	var methods []string
+	var body dataGroupNoRecords
+	mux.HandleFunc("/mgmt/tm/ltm/data-group/internal/~Common~blocklist", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		assert.Empty(t, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			dg, _ := json.Marshal(&bigip.DataGroup{Name: "blocklist", FullPath: "/Common/blocklist", Type: "ip", Records: records})
+			_, _ = w.Write(dg)
+			return
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		_, _ = fmt.Fprintf(w, `{"name":"blocklist","fullPath":"/Common/blocklist","type":"ip"}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	err := modifyDataGroupRecords(client, "/Common/blocklist", []bigip.DataGroupRecord{{Name: "10.1.1.1/32", Data: long}}, nil, []string{"10.0.0.0/24"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET", "PUT"}, methods)
+	assert.Len(t, body.Records, 50)
+	assert.Equal(t, "10.0.1.0/24", body.Records[0].Name)
+	assert.Equal(t, bigip.DataGroupRecord{Name: "10.1.1.1/32", Data: long}, body.Records[49])
+
+	var keys []string
+	for _, r := range records {
+		keys = append(keys, r.Name)
+	}
+	methods = nil
+	err = modifyDataGroupRecords(client, "/Common/blocklist", nil, nil, keys)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GET", "PATCH"}, methods)
+	assert.NotNil(t, body.Records)
+	assert.Empty(t, body.Records)
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmPersistenceHash = "ltm/persistence/hash"
+
+// bigip.HashPersistenceProfile has no rule attribute and decodes the start/end
+// patterns as integers, while BIG-IP returns them as strings, so the profile is
+// managed with its own struct.
+type persistenceProfileHash struct {
+	bigip.PersistenceProfile
+	HashAlgorithm    string `json:"hashAlgorithm,omitempty"`
+	HashBufferLimit  int    `json:"hashBufferLimit,omitempty"`
+	HashLength       int    `json:"hashLength"`
+	HashOffset       int    `json:"hashOffset"`
+	HashStartPattern string `json:"hashStartPattern,omitempty"`
+	HashEndPattern   string `json:"hashEndPattern,omitempty"`
+	Rule             string `json:"rule,omitempty"`
+}
+
+func resourceBigipLtmPersistenceProfileHash() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmPersistenceProfileHashCreate,
+		ReadContext:   resourceBigipLtmPersistenceProfileHashRead,
+		UpdateContext: resourceBigipLtmPersistenceProfileHashUpdate,
+		DeleteContext: resourceBigipLtmPersistenceProfileHashDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the persistence profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/Common/hash",
+				Description:  "Inherit defaults from parent profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"match_across_pools": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across pools with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_services": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across services with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_virtuals": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across virtual servers with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"mirror": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable mirroring of persistence records to the peer",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout for persistence of the session in seconds",
+			},
+
+			"override_conn_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable that pool member connection limits are overridden for persisted clients",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			// Specific to hash persistence
+			"rule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "iRule whose result is hashed to select the pool member",
+				ValidateFunc: validateF5Name,
+			},
+
+			"hash_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Hash algorithm, `default` (index) or `carp`",
+				ValidateFunc: validation.StringInSlice([]string{"default", "carp"}, false),
+			},
+
+			"hash_offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Start of the data to hash, in bytes from the beginning of the payload",
+			},
+
+			"hash_length": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of bytes to hash starting at `hash_offset`",
+			},
+
+			"hash_start_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Pattern marking the start of the data to hash",
+			},
+
+			"hash_end_pattern": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Pattern marking the end of the data to hash",
+			},
+
+			"hash_buffer_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of bytes buffered while searching for the hash patterns",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmPersistenceProfileHashCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Hash Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileHashConfig(d)
+	pp.Name = name
+
+	err := createTmEntity(client, pp, uriLtmPersistenceHash)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Hash Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+
+	return resourceBigipLtmPersistenceProfileHashRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileHashRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Fetching Hash Persistence Profile " + name)
+
+	var pp persistenceProfileHash
+	found, err := getTmEntity(client, &pp, uriLtmPersistenceHash, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Hash Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Hash Persistence Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", pp.DefaultsFrom)
+	_ = d.Set("description", pp.Description)
+	_ = d.Set("match_across_pools", pp.MatchAcrossPools)
+	_ = d.Set("match_across_services", pp.MatchAcrossServices)
+	_ = d.Set("match_across_virtuals", pp.MatchAcrossVirtuals)
+	_ = d.Set("mirror", pp.Mirror)
+	_ = d.Set("override_conn_limit", pp.OverrideConnectionLimit)
+	if timeout, err := strconv.Atoi(pp.Timeout); err == nil {
+		_ = d.Set("timeout", timeout)
+	}
+
+	// Specific to hash persistence
+	if pp.Rule == "none" {
+		pp.Rule = ""
+	}
+	_ = d.Set("rule", pp.Rule)
+	_ = d.Set("hash_algorithm", pp.HashAlgorithm)
+	_ = d.Set("hash_offset", pp.HashOffset)
+	_ = d.Set("hash_length", pp.HashLength)
+	_ = d.Set("hash_start_pattern", pp.HashStartPattern)
+	_ = d.Set("hash_end_pattern", pp.HashEndPattern)
+	_ = d.Set("hash_buffer_limit", pp.HashBufferLimit)
+
+	return nil
+}
+
+func resourceBigipLtmPersistenceProfileHashUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Updating Hash Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileHashConfig(d)
+	// An empty rule is dropped by omitempty, so removing the rule needs "none".
+	if pp.Rule == "" {
+		pp.Rule = "none"
+	}
+	err := modifyTmEntity(client, pp, uriLtmPersistenceHash, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Hash Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+
+	return resourceBigipLtmPersistenceProfileHashRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileHashDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Hash Persistence Profile " + name)
+	err := client.DeleteHashPersistenceProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Hash Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getPersistenceProfileHashConfig(d *schema.ResourceData) *persistenceProfileHash {
+	pp := &persistenceProfileHash{
+		PersistenceProfile: bigip.PersistenceProfile{
+			DefaultsFrom:            d.Get("defaults_from").(string),
+			Description:             d.Get("description").(string),
+			MatchAcrossPools:        d.Get("match_across_pools").(string),
+			MatchAcrossServices:     d.Get("match_across_services").(string),
+			MatchAcrossVirtuals:     d.Get("match_across_virtuals").(string),
+			Mirror:                  d.Get("mirror").(string),
+			OverrideConnectionLimit: d.Get("override_conn_limit").(string),
+		},
+		Rule:             d.Get("rule").(string),
+		HashAlgorithm:    d.Get("hash_algorithm").(string),
+		HashOffset:       d.Get("hash_offset").(int),
+		HashLength:       d.Get("hash_length").(int),
+		HashStartPattern: d.Get("hash_start_pattern").(string),
+		HashEndPattern:   d.Get("hash_end_pattern").(string),
+		HashBufferLimit:  d.Get("hash_buffer_limit").(int),
+	}
+	if timeout := d.Get("timeout").(int); timeout != 0 {
+		pp.Timeout = strconv.Itoa(timeout)
+	}
+	return pp
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestPphashName = fmt.Sprintf("/%s/test-pphash", TestPartition)
+
+var TestPphashResource = `
+resource "bigip_ltm_irule" "test_pphash_rule" {
+	name  = "/Common/test-pphash-rule"
+	irule = <<EOT
+when HTTP_REQUEST {
+  persist hash [HTTP::header value "X-Session-Id"]
+}
+EOT
+}
+
+resource "bigip_ltm_persistence_profile_hash" "test_pphash" {
+	name                  = "` + TestPphashName + `"
+	defaults_from         = "/Common/hash"
+	rule                  = bigip_ltm_irule.test_pphash_rule.name
+	hash_algorithm        = "carp"
+	hash_offset           = 10
+	hash_length           = 16
+	timeout               = 600
+	match_across_pools    = "enabled"
+	match_across_services = "enabled"
+	match_across_virtuals = "disabled"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileHashCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileHashDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPphashResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileHashExists(TestPphashName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "name", TestPphashName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "defaults_from", "/Common/hash"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "rule", "/Common/test-pphash-rule"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "hash_algorithm", "carp"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "hash_offset", "10"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "hash_length", "16"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "timeout", "600"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "match_across_pools", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "match_across_services", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_hash.test_pphash", "match_across_virtuals", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPersistenceProfileHashImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileHashDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPphashResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_persistence_profile_hash.test_pphash",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileHashExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		var pp persistenceProfileHash
+		found, err := getTmEntity(client, &pp, uriLtmPersistenceHash, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Hash Persistence Profile %s does not exist.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileHashDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_hash" {
+			continue
+		}
+
+		var pp persistenceProfileHash
+		found, err := getTmEntity(client, &pp, uriLtmPersistenceHash, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Hash Persistence Profile %s not destroyed.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
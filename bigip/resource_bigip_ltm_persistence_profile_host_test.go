@@ -0,0 +1,102 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestPphostName = fmt.Sprintf("/%s/test-pphost", TestPartition)
+
+var TestPphostResource = `
+resource "bigip_ltm_persistence_profile_host" "test_pphost" {
+	name                  = "` + TestPphostName + `"
+	defaults_from         = "/Common/host"
+	timeout               = 900
+	match_across_virtuals = "enabled"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileHostCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileHostDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPphostResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileHostExists(TestPphostName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_host.test_pphost", "name", TestPphostName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_host.test_pphost", "defaults_from", "/Common/host"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_host.test_pphost", "timeout", "900"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_host.test_pphost", "match_across_virtuals", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPersistenceProfileHostImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileHostDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPphostResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_persistence_profile_host.test_pphost",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileHostExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		pp, err := client.GetHostPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+		if pp == nil {
+			return fmt.Errorf("Host Persistence Profile %s does not exist.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileHostDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_host" {
+			continue
+		}
+
+		pp, err := client.GetHostPersistenceProfile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pp != nil {
+			return fmt.Errorf("Host Persistence Profile %s not destroyed.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBigipLtmPersistenceProfileMSRDP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmPersistenceProfileMSRDPCreate,
+		ReadContext:   resourceBigipLtmPersistenceProfileMSRDPRead,
+		UpdateContext: resourceBigipLtmPersistenceProfileMSRDPUpdate,
+		DeleteContext: resourceBigipLtmPersistenceProfileMSRDPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the persistence profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/Common/msrdp",
+				Description:  "Inherit defaults from parent profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"match_across_pools": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across pools with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_services": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across services with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_virtuals": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across virtual servers with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"mirror": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable mirroring of persistence records to the peer",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout for persistence of the session in seconds",
+			},
+
+			"override_conn_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable that pool member connection limits are overridden for persisted clients",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			// Specific to MSRDPPersistenceProfile
+			"has_session_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Set to `yes` when the RDP servers use a Session Directory (Connection Broker), so reconnects go to the server owning the session",
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+			},
+		},
+	}
+}
+
+func resourceBigipLtmPersistenceProfileMSRDPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating MSRDP Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileMSRDPConfig(d)
+	pp.Name = name
+
+	err := client.AddMSRDPPersistenceProfile(pp)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create MSRDP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+
+	return resourceBigipLtmPersistenceProfileMSRDPRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileMSRDPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Fetching MSRDP Persistence Profile " + name)
+
+	pp, err := client.GetMSRDPPersistenceProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve MSRDP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if pp == nil {
+		log.Printf("[WARN] MSRDP Persistence Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", pp.DefaultsFrom)
+	_ = d.Set("description", pp.Description)
+	_ = d.Set("match_across_pools", pp.MatchAcrossPools)
+	_ = d.Set("match_across_services", pp.MatchAcrossServices)
+	_ = d.Set("match_across_virtuals", pp.MatchAcrossVirtuals)
+	_ = d.Set("mirror", pp.Mirror)
+	_ = d.Set("override_conn_limit", pp.OverrideConnectionLimit)
+	if timeout, err := strconv.Atoi(pp.Timeout); err == nil {
+		_ = d.Set("timeout", timeout)
+	}
+
+	// Specific to MSRDPPersistenceProfile
+	_ = d.Set("has_session_dir", pp.HasSessionDir)
+
+	return nil
+}
+
+func resourceBigipLtmPersistenceProfileMSRDPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Updating MSRDP Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileMSRDPConfig(d)
+	err := client.ModifyMSRDPPersistenceProfile(name, pp)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify MSRDP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+
+	return resourceBigipLtmPersistenceProfileMSRDPRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileMSRDPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting MSRDP Persistence Profile " + name)
+	err := client.DeleteMSRDPPersistenceProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete MSRDP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getPersistenceProfileMSRDPConfig(d *schema.ResourceData) *bigip.MSRDPPersistenceProfile {
+	pp := &bigip.MSRDPPersistenceProfile{
+		PersistenceProfile: bigip.PersistenceProfile{
+			DefaultsFrom:            d.Get("defaults_from").(string),
+			Description:             d.Get("description").(string),
+			MatchAcrossPools:        d.Get("match_across_pools").(string),
+			MatchAcrossServices:     d.Get("match_across_services").(string),
+			MatchAcrossVirtuals:     d.Get("match_across_virtuals").(string),
+			Mirror:                  d.Get("mirror").(string),
+			OverrideConnectionLimit: d.Get("override_conn_limit").(string),
+		},
+		HasSessionDir: d.Get("has_session_dir").(string),
+	}
+	if timeout := d.Get("timeout").(int); timeout != 0 {
+		pp.Timeout = strconv.Itoa(timeout)
+	}
+	return pp
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestPpmsrdpName = fmt.Sprintf("/%s/test-ppmsrdp", TestPartition)
+
+var TestPpmsrdpResource = `
+resource "bigip_ltm_persistence_profile_msrdp" "test_ppmsrdp" {
+	name               = "` + TestPpmsrdpName + `"
+	defaults_from      = "/Common/msrdp"
+	has_session_dir    = "yes"
+	timeout            = 3600
+	match_across_pools = "enabled"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileMSRDPCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileMSRDPDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpmsrdpResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileMSRDPExists(TestPpmsrdpName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_msrdp.test_ppmsrdp", "name", TestPpmsrdpName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_msrdp.test_ppmsrdp", "defaults_from", "/Common/msrdp"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_msrdp.test_ppmsrdp", "has_session_dir", "yes"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_msrdp.test_ppmsrdp", "timeout", "3600"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_msrdp.test_ppmsrdp", "match_across_pools", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPersistenceProfileMSRDPImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileMSRDPDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpmsrdpResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_persistence_profile_msrdp.test_ppmsrdp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileMSRDPExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		pp, err := client.GetMSRDPPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+		if pp == nil {
+			return fmt.Errorf("MSRDP Persistence Profile %s does not exist.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileMSRDPDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_msrdp" {
+			continue
+		}
+
+		pp, err := client.GetMSRDPPersistenceProfile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pp != nil {
+			return fmt.Errorf("MSRDP Persistence Profile %s not destroyed.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
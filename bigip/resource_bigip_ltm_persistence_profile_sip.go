@@ -0,0 +1,210 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBigipLtmPersistenceProfileSIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmPersistenceProfileSIPCreate,
+		ReadContext:   resourceBigipLtmPersistenceProfileSIPRead,
+		UpdateContext: resourceBigipLtmPersistenceProfileSIPUpdate,
+		DeleteContext: resourceBigipLtmPersistenceProfileSIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the persistence profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "/Common/sip_info",
+				Description:  "Inherit defaults from parent profile",
+				ValidateFunc: validateF5Name,
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+
+			"match_across_pools": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across pools with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_services": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across services with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"match_across_virtuals": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable match across virtual servers with given persistence record",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"mirror": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable mirroring of persistence records to the peer",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Timeout for persistence of the session in seconds",
+			},
+
+			"override_conn_limit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "To enable _ disable that pool member connection limits are overridden for persisted clients",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+
+			// Specific to SIPPersistenceProfile
+			"sip_info": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SIP header used to identify the session, for example `Call-ID`",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmPersistenceProfileSIPCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating SIP Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileSIPConfig(d)
+	pp.Name = name
+
+	err := client.AddSIPPersistenceProfile(pp)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create SIP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+
+	return resourceBigipLtmPersistenceProfileSIPRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileSIPRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Fetching SIP Persistence Profile " + name)
+
+	pp, err := client.GetSIPPersistenceProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve SIP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if pp == nil {
+		log.Printf("[WARN] SIP Persistence Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", pp.DefaultsFrom)
+	_ = d.Set("description", pp.Description)
+	_ = d.Set("match_across_pools", pp.MatchAcrossPools)
+	_ = d.Set("match_across_services", pp.MatchAcrossServices)
+	_ = d.Set("match_across_virtuals", pp.MatchAcrossVirtuals)
+	_ = d.Set("mirror", pp.Mirror)
+	_ = d.Set("override_conn_limit", pp.OverrideConnectionLimit)
+	if timeout, err := strconv.Atoi(pp.Timeout); err == nil {
+		_ = d.Set("timeout", timeout)
+	}
+
+	// Specific to SIPPersistenceProfile
+	_ = d.Set("sip_info", pp.SIPInfo)
+
+	return nil
+}
+
+func resourceBigipLtmPersistenceProfileSIPUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Printf("[INFO] Updating SIP Persistence Profile:%+v ", name)
+
+	pp := getPersistenceProfileSIPConfig(d)
+	err := client.ModifySIPPersistenceProfile(name, pp)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify SIP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+
+	return resourceBigipLtmPersistenceProfileSIPRead(ctx, d, meta)
+}
+
+func resourceBigipLtmPersistenceProfileSIPDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting SIP Persistence Profile " + name)
+	err := client.DeleteSIPPersistenceProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete SIP Persistence Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getPersistenceProfileSIPConfig(d *schema.ResourceData) *bigip.SIPPersistenceProfile {
+	pp := &bigip.SIPPersistenceProfile{
+		PersistenceProfile: bigip.PersistenceProfile{
+			DefaultsFrom:            d.Get("defaults_from").(string),
+			Description:             d.Get("description").(string),
+			MatchAcrossPools:        d.Get("match_across_pools").(string),
+			MatchAcrossServices:     d.Get("match_across_services").(string),
+			MatchAcrossVirtuals:     d.Get("match_across_virtuals").(string),
+			Mirror:                  d.Get("mirror").(string),
+			OverrideConnectionLimit: d.Get("override_conn_limit").(string),
+		},
+		SIPInfo: d.Get("sip_info").(string),
+	}
+	if timeout := d.Get("timeout").(int); timeout != 0 {
+		pp.Timeout = strconv.Itoa(timeout)
+	}
+	return pp
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestPpsipName = fmt.Sprintf("/%s/test-ppsip", TestPartition)
+
+var TestPpsipResource = `
+resource "bigip_ltm_persistence_profile_sip" "test_ppsip" {
+	name                  = "` + TestPpsipName + `"
+	defaults_from         = "/Common/sip_info"
+	sip_info              = "Call-ID"
+	timeout               = 300
+	match_across_services = "enabled"
+	mirror                = "enabled"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileSIPCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileSIPDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpsipResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileSIPExists(TestPpsipName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "name", TestPpsipName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "defaults_from", "/Common/sip_info"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "sip_info", "Call-ID"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "timeout", "300"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "match_across_services", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_sip.test_ppsip", "mirror", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPersistenceProfileSIPImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileSIPDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpsipResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_persistence_profile_sip.test_ppsip",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileSIPExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		pp, err := client.GetSIPPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+		if pp == nil {
+			return fmt.Errorf("SIP Persistence Profile %s does not exist.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileSIPDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_sip" {
+			continue
+		}
+
+		pp, err := client.GetSIPPersistenceProfile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pp != nil {
+			return fmt.Errorf("SIP Persistence Profile %s not destroyed.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
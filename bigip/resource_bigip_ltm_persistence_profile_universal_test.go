@@ -0,0 +1,117 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestPpuniversalName = fmt.Sprintf("/%s/test-ppuniversal", TestPartition)
+
+var TestPpuniversalResource = `
+resource "bigip_ltm_irule" "test_ppuniversal_rule" {
+	name  = "/Common/test-ppuniversal-rule"
+	irule = <<EOT
+when HTTP_REQUEST {
+  persist uie [HTTP::cookie value "JSESSIONID"]
+}
+EOT
+}
+
+resource "bigip_ltm_persistence_profile_universal" "test_ppuniversal" {
+	name                  = "` + TestPpuniversalName + `"
+	defaults_from         = "/Common/universal"
+	rule                  = bigip_ltm_irule.test_ppuniversal_rule.name
+	timeout               = 1800
+	match_across_pools    = "disabled"
+	match_across_services = "enabled"
+	mirror                = "enabled"
+}
+`
+
+func TestAccBigipLtmPersistenceProfileUniversalCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileUniversalDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpuniversalResource,
+				Check: resource.ComposeTestCheckFunc(
+					testBigipLtmPersistenceProfileUniversalExists(TestPpuniversalName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "name", TestPpuniversalName),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "defaults_from", "/Common/universal"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "rule", "/Common/test-ppuniversal-rule"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "timeout", "1800"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "match_across_pools", "disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "match_across_services", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_persistence_profile_universal.test_ppuniversal", "mirror", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmPersistenceProfileUniversalImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipLtmPersistenceProfileUniversalDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestPpuniversalResource,
+			},
+			{
+				ResourceName:      "bigip_ltm_persistence_profile_universal.test_ppuniversal",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testBigipLtmPersistenceProfileUniversalExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+
+		pp, err := client.GetUniversalPersistenceProfile(name)
+		if err != nil {
+			return err
+		}
+		if pp == nil {
+			return fmt.Errorf("Universal Persistence Profile %s does not exist.", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipLtmPersistenceProfileUniversalDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_persistence_profile_universal" {
+			continue
+		}
+
+		pp, err := client.GetUniversalPersistenceProfile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if pp != nil {
+			return fmt.Errorf("Universal Persistence Profile %s not destroyed.", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmProfileAnalytics = "ltm/profile/analytics"
+
+// Threshold alerts live in the alerts subcollection of the profile, which
+// go-bigip only exposes as a reference.
+type analyticsAlert struct {
+	Name                 string `json:"name,omitempty"`
+	Metric               string `json:"metric,omitempty"`
+	Threshold            int    `json:"threshold"`
+	ThresholdRelation    string `json:"thresholdRelation,omitempty"`
+	ThresholdTime        int    `json:"thresholdTime,omitempty"`
+	ThresholdGranularity string `json:"thresholdGranularity,omitempty"`
+}
+
+type analyticsAlerts struct {
+	Alerts []analyticsAlert `json:"items"`
+}
+
+var analyticsCollectFlags = map[string]string{
+	"collect_geo":                    "Collect statistics per client country",
+	"collect_ip":                     "Collect statistics per client IP address",
+	"collect_methods":                "Collect statistics per HTTP method",
+	"collect_response_codes":         "Collect statistics per HTTP response code",
+	"collect_url":                    "Collect statistics per URL",
+	"collect_user_agent":             "Collect statistics per user agent",
+	"collect_user_sessions":          "Collect statistics about user sessions",
+	"collect_os_and_browser":         "Collect statistics per client OS and browser",
+	"collect_subnets":                "Collect statistics per client subnet",
+	"collect_page_load_time":         "Collect page load time measured by injected JavaScript",
+	"collect_http_timing_metrics":    "Collect HTTP timing metrics",
+	"collect_max_tps_and_throughput": "Collect maximum TPS and throughput",
+}
+
+func resourceBigipLtmProfileAnalytics() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateF5NameWithDirectory,
+			Description:  "Name of the Analytics Profile",
+		},
+		"partition": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "name of partition",
+		},
+		"defaults_from": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateF5Name,
+			Description:  "Use the parent analytics profile",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User defined description",
+		},
+		"sampling": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Sample the traffic instead of analyzing every transaction",
+		},
+		"countries_for_stat_collection": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Countries always collected as separate entities",
+		},
+		"ips_for_stat_collection": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Client IP addresses always collected as separate entities",
+		},
+		"subnets_for_stat_collection": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Client subnets always collected as separate entities",
+		},
+		"urls_for_stat_collection": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "URLs always collected as separate entities",
+		},
+		"collected_stats_internal_logging": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Store the statistics on the BIG-IP for the Analytics GUI",
+		},
+		"collected_stats_external_logging": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Send the statistics to `external_logging_publisher`",
+		},
+		"external_logging_publisher": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateF5Name,
+			Description:  "Log publisher (remote high speed logging) receiving the statistics",
+		},
+		"notification_by_syslog": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Send alert notifications to syslog",
+		},
+		"notification_by_snmp": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Send alert notifications as SNMP traps",
+		},
+		"notification_by_email": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  "Send alert notifications by e-mail",
+		},
+		"notification_email_addresses": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "E-mail addresses receiving alert notifications",
+		},
+		"smtp_config": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateF5Name,
+			Description:  "SMTP configuration used to send e-mail notifications",
+		},
+		"alert": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Threshold alerts raised when a metric crosses a value",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the alert",
+					},
+					"metric": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Metric the alert watches, for example `average-tps` or `average-server-latency`",
+					},
+					"threshold": {
+						Type:        schema.TypeInt,
+						Required:    true,
+						Description: "Value the metric is compared against",
+					},
+					"threshold_relation": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "above",
+						ValidateFunc: validation.StringInSlice([]string{"above", "below"}, false),
+						Description:  "Raise the alert when the metric is `above` or `below` the threshold",
+					},
+					"threshold_time": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Default:     300,
+						Description: "Seconds the condition must hold before the alert is raised",
+					},
+					"threshold_granularity": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "application",
+						ValidateFunc: validation.StringInSlice([]string{"application", "virtual-server", "pool-member"}, false),
+						Description:  "Entity the metric is evaluated for",
+					},
+				},
+			},
+		},
+	}
+	for flag, description := range analyticsCollectFlags {
+		s[flag] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			Description:  description,
+		}
+	}
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileAnalyticsCreate,
+		UpdateContext: resourceBigipLtmProfileAnalyticsUpdate,
+		ReadContext:   resourceBigipLtmProfileAnalyticsRead,
+		DeleteContext: resourceBigipLtmProfileAnalyticsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: s,
+	}
+}
+
+func resourceBigipLtmProfileAnalyticsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+
+	if err := checkModuleProvisioned(client, "avr"); err != nil {
+		return diag.FromErr(err)
+	}
+	analyticsConfig := &bigip.AnalyticsProfile{
+		Name: name,
+	}
+	analyticsProfileConfig := getAnalyticsProfileConfig(d, analyticsConfig)
+	log.Println("[INFO] Creating Analytics profile")
+	err := client.AddAnalyticsProfile(analyticsProfileConfig)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create analytics Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := setAnalyticsAlerts(client, name, d.Get("alert").([]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipLtmProfileAnalyticsRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileAnalyticsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating Analytics Profile " + name)
+	analyticsConfig := &bigip.AnalyticsProfile{
+		Name: name,
+	}
+	analyticsProfileConfig := getAnalyticsProfileConfig(d, analyticsConfig)
+	err := client.ModifyAnalyticsProfile(name, analyticsProfileConfig)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile analytics (%s): %s", name, err))
+	}
+	if d.HasChange("alert") {
+		if err := setAnalyticsAlerts(client, name, d.Get("alert").([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipLtmProfileAnalyticsRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileAnalyticsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading Analytics Profile  " + name)
+	obj, err := client.GetAnalyticsProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve analytics Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading Analytics Object:%+v ", obj)
+	if obj == nil {
+		log.Printf("[WARN] analytics  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("sampling", obj.Sampling)
+	_ = d.Set("collect_geo", obj.CollectGeo)
+	_ = d.Set("collect_ip", obj.CollectIp)
+	_ = d.Set("collect_methods", obj.CollectMethods)
+	_ = d.Set("collect_response_codes", obj.CollectResponseCodes)
+	_ = d.Set("collect_url", obj.CollectUrl)
+	_ = d.Set("collect_user_agent", obj.CollectUserAgent)
+	_ = d.Set("collect_user_sessions", obj.CollectUserSessions)
+	_ = d.Set("collect_os_and_browser", obj.CollectOsAndBrowser)
+	_ = d.Set("collect_subnets", obj.CollectSubnets)
+	_ = d.Set("collect_page_load_time", obj.CollectPageLoadTime)
+	_ = d.Set("collect_http_timing_metrics", obj.CollectHttpTimingMetrics)
+	_ = d.Set("collect_max_tps_and_throughput", obj.CollectMaxTpsAndThroughput)
+	_ = d.Set("countries_for_stat_collection", obj.CountriesForStatCollection)
+	_ = d.Set("ips_for_stat_collection", obj.IpsForStatCollection)
+	_ = d.Set("subnets_for_stat_collection", obj.SubnetsForStatCollection)
+	_ = d.Set("urls_for_stat_collection", obj.UrlsForStatCollection)
+	_ = d.Set("collected_stats_internal_logging", obj.CollectedStatsInternalLogging)
+	_ = d.Set("collected_stats_external_logging", obj.CollectedStatsExternalLogging)
+	_ = d.Set("notification_by_syslog", obj.NotificationBySyslog)
+	_ = d.Set("notification_by_snmp", obj.NotificationBySnmp)
+	_ = d.Set("notification_by_email", obj.NotificationByEmail)
+	_ = d.Set("notification_email_addresses", obj.NotificationEmailAddresses)
+	_ = d.Set("external_logging_publisher", noneToEmpty(obj.ExternalLoggingPublisher))
+	_ = d.Set("smtp_config", noneToEmpty(obj.SmtpConfig))
+
+	var alerts analyticsAlerts
+	if _, err := getTmEntity(client, &alerts, uriLtmProfileAnalytics, name, "alerts"); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading alerts of analytics profile (%s): %s", name, err))
+	}
+	var alertList []interface{}
+	for _, a := range alerts.Alerts {
+		alertList = append(alertList, map[string]interface{}{
+			"name":                  a.Name,
+			"metric":                a.Metric,
+			"threshold":             a.Threshold,
+			"threshold_relation":    a.ThresholdRelation,
+			"threshold_time":        a.ThresholdTime,
+			"threshold_granularity": a.ThresholdGranularity,
+		})
+	}
+	if err := d.Set("alert", alertList); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving alert to state for analytics Profile (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipLtmProfileAnalyticsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Analytics Profile " + name)
+
+	err := client.DeleteAnalyticsProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete analytics Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getAnalyticsProfileConfig(d *schema.ResourceData, config *bigip.AnalyticsProfile) *bigip.AnalyticsProfile {
+	config.Partition = d.Get("partition").(string)
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.Sampling = d.Get("sampling").(string)
+	config.CollectGeo = d.Get("collect_geo").(string)
+	config.CollectIp = d.Get("collect_ip").(string)
+	config.CollectMethods = d.Get("collect_methods").(string)
+	config.CollectResponseCodes = d.Get("collect_response_codes").(string)
+	config.CollectUrl = d.Get("collect_url").(string)
+	config.CollectUserAgent = d.Get("collect_user_agent").(string)
+	config.CollectUserSessions = d.Get("collect_user_sessions").(string)
+	config.CollectOsAndBrowser = d.Get("collect_os_and_browser").(string)
+	config.CollectSubnets = d.Get("collect_subnets").(string)
+	config.CollectPageLoadTime = d.Get("collect_page_load_time").(string)
+	config.CollectHttpTimingMetrics = d.Get("collect_http_timing_metrics").(string)
+	config.CollectMaxTpsAndThroughput = d.Get("collect_max_tps_and_throughput").(string)
+	config.CountriesForStatCollection = setToStringSlice(d.Get("countries_for_stat_collection").(*schema.Set))
+	config.IpsForStatCollection = setToStringSlice(d.Get("ips_for_stat_collection").(*schema.Set))
+	config.SubnetsForStatCollection = setToStringSlice(d.Get("subnets_for_stat_collection").(*schema.Set))
+	config.UrlsForStatCollection = setToStringSlice(d.Get("urls_for_stat_collection").(*schema.Set))
+	config.CollectedStatsInternalLogging = d.Get("collected_stats_internal_logging").(string)
+	config.CollectedStatsExternalLogging = d.Get("collected_stats_external_logging").(string)
+	config.ExternalLoggingPublisher = emptyToNone(d.Get("external_logging_publisher").(string))
+	config.NotificationBySyslog = d.Get("notification_by_syslog").(string)
+	config.NotificationBySnmp = d.Get("notification_by_snmp").(string)
+	config.NotificationByEmail = d.Get("notification_by_email").(string)
+	config.NotificationEmailAddresses = setToStringSlice(d.Get("notification_email_addresses").(*schema.Set))
+	config.SmtpConfig = emptyToNone(d.Get("smtp_config").(string))
+	return config
+}
+
+// setAnalyticsAlerts makes the alerts subcollection of the profile match the
+// configured alert blocks.
+func setAnalyticsAlerts(client *bigip.BigIP, name string, items []interface{}) error {
+	var current analyticsAlerts
+	if _, err := getTmEntity(client, &current, uriLtmProfileAnalytics, name, "alerts"); err != nil {
+		return fmt.Errorf("error reading alerts of analytics profile (%s): %s", name, err)
+	}
+	existing := make(map[string]bool)
+	for _, a := range current.Alerts {
+		existing[a.Name] = true
+	}
+	wanted := make(map[string]bool)
+	for _, item := range items {
+		a := item.(map[string]interface{})
+		alert := &analyticsAlert{
+			Name:                 a["name"].(string),
+			Metric:               a["metric"].(string),
+			Threshold:            a["threshold"].(int),
+			ThresholdRelation:    a["threshold_relation"].(string),
+			ThresholdTime:        a["threshold_time"].(int),
+			ThresholdGranularity: a["threshold_granularity"].(string),
+		}
+		wanted[alert.Name] = true
+		var err error
+		if existing[alert.Name] {
+			err = modifyTmEntity(client, alert, uriLtmProfileAnalytics, name, "alerts", alert.Name)
+		} else {
+			err = createTmEntity(client, alert, uriLtmProfileAnalytics, name, "alerts")
+		}
+		if err != nil {
+			return fmt.Errorf("error writing alert %s of analytics profile (%s): %s", alert.Name, name, err)
+		}
+	}
+	for alertName := range existing {
+		if wanted[alertName] {
+			continue
+		}
+		if err := deleteTmEntity(client, uriLtmProfileAnalytics, name, "alerts", alertName); err != nil {
+			return fmt.Errorf("error deleting alert %s of analytics profile (%s): %s", alertName, name, err)
+		}
+	}
+	return nil
+}
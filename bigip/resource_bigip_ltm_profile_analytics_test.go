@@ -0,0 +1,167 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestAnalyticsName = fmt.Sprintf("/%s/test-analytics", TestPartition)
+
+func TestAccBigipLtmProfileAnalytics_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAnalyticsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileAnalyticsConfig(TestAnalyticsName, "enabled", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAnalyticsExists(TestAnalyticsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "name", TestAnalyticsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "defaults_from", "/Common/analytics"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_url", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_geo", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "urls_for_stat_collection.#", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.#", "1"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.metric", "average-tps"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.threshold", "1000"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-analytics-vs", "profiles.#", "2"),
+				),
+			},
+			{
+				Config: getProfileAnalyticsConfig(TestAnalyticsName, "disabled", 500),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAnalyticsExists(TestAnalyticsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "collect_geo", "disabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "alert.0.threshold", "500"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileAnalytics_removePublisher(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAnalyticsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileAnalyticsPublisherConfig(TestAnalyticsName, `"/Common/local-db-publisher"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAnalyticsExists(TestAnalyticsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "external_logging_publisher", "/Common/local-db-publisher"),
+				),
+			},
+			{
+				Config: getProfileAnalyticsPublisherConfig(TestAnalyticsName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAnalyticsExists(TestAnalyticsName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_analytics.test-analytics", "external_logging_publisher", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileAnalytics_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAnalyticsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileAnalyticsConfig(TestAnalyticsName, "enabled", 1000),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_analytics.test-analytics",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckAnalyticsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetAnalyticsProfile(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("analytics %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckAnalyticsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_analytics" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		analytics, err := client.GetAnalyticsProfile(name)
+		if err != nil {
+			return err
+		}
+		if analytics != nil {
+			return fmt.Errorf("analytics %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileAnalyticsConfig(profileName, collectGeo string, threshold int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_analytics" "test-analytics" {
+  name                     = "%s"
+  defaults_from            = "/Common/analytics"
+  collect_url              = "enabled"
+  collect_geo              = "%s"
+  urls_for_stat_collection = ["/login"]
+  alert {
+    name      = "tps-alert"
+    metric    = "average-tps"
+    threshold = %d
+  }
+}
+
+resource "bigip_ltm_virtual_server" "test-analytics-vs" {
+  name        = "/Common/test-analytics-vs"
+  destination = "10.255.255.20"
+  port        = 80
+  profiles    = ["/Common/http", bigip_ltm_profile_analytics.test-analytics.name]
+}
+`, profileName, collectGeo, threshold)
+}
+
+func getProfileAnalyticsPublisherConfig(profileName, publisher string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_analytics" "test-analytics" {
+  name                       = "%s"
+  defaults_from              = "/Common/analytics"
+  external_logging_publisher = %s
+}
+`, profileName, publisher)
+}
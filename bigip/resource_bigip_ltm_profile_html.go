@@ -0,0 +1,423 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	uriLtmProfileHTML = "ltm/profile/html"
+	uriLtmHTMLRule    = "ltm/html-rule"
+)
+
+var htmlRuleTypes = []string{
+	"comment-raise-event",
+	"comment-remove",
+	"tag-append-html",
+	"tag-prepend-html",
+	"tag-raise-event",
+	"tag-remove",
+	"tag-remove-attribute",
+}
+
+// bigip.HTMLProfile does not carry the rules attribute, so the profile is
+// managed with its own struct.
+type ltmProfileHTML struct {
+	bigip.HTMLProfile
+	Rules []string `json:"rules"`
+}
+
+type ltmHTMLRuleMatch struct {
+	TagName        string `json:"tagName,omitempty"`
+	AttributeName  string `json:"attributeName,omitempty"`
+	AttributeValue string `json:"attributeValue,omitempty"`
+}
+
+type ltmHTMLRuleAction struct {
+	Text          string `json:"text,omitempty"`
+	AttributeName string `json:"attributeName,omitempty"`
+}
+
+type ltmHTMLRule struct {
+	Name        string             `json:"name,omitempty"`
+	FullPath    string             `json:"fullPath,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Match       *ltmHTMLRuleMatch  `json:"match,omitempty"`
+	Action      *ltmHTMLRuleAction `json:"action,omitempty"`
+}
+
+func resourceBigipLtmProfileHtml() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileHtmlCreate,
+		UpdateContext: resourceBigipLtmProfileHtmlUpdate,
+		ReadContext:   resourceBigipLtmProfileHtmlRead,
+		DeleteContext: resourceBigipLtmProfileHtmlDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the HTML Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent html profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"content_detection": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Detect HTML by inspecting the payload instead of relying on the Content-Type header only",
+			},
+			"content_selection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types that are parsed as HTML, for example `text/html`",
+			},
+			"content_rule": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "HTML content rules (ltm html-rule) created for and attached to this profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "Name of the HTML rule",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(htmlRuleTypes, false),
+							Description:  "Kind of HTML rule",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User defined description",
+						},
+						"match_tag_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Tag the rule matches, for example `/head` for the closing head tag",
+						},
+						"match_attribute_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Attribute the matched tag must carry",
+						},
+						"match_attribute_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value the matched attribute must have",
+						},
+						"action_text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "HTML inserted by `tag-append-html` and `tag-prepend-html` rules",
+						},
+						"action_attribute_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Attribute removed by `tag-remove-attribute` rules",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileHtmlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Println("[INFO] Creating HTML profile")
+
+	rules := d.Get("content_rule").(*schema.Set).List()
+	for _, r := range rules {
+		if err := putHTMLRule(client, r.(map[string]interface{}), true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	htmlConfig := &ltmProfileHTML{}
+	htmlConfig.Name = name
+	htmlProfileConfig := getHTMLProfileConfig(d, htmlConfig)
+	err := createTmEntity(client, htmlProfileConfig, uriLtmProfileHTML)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create html Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileHtmlRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileHtmlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating HTML Profile " + name)
+
+	o, n := d.GetChange("content_rule")
+	htmlConfig := &ltmProfileHTML{}
+	htmlConfig.Name = name
+	htmlProfileConfig := getHTMLProfileConfig(d, htmlConfig)
+	err := updateHTMLProfile(client, name, htmlProfileConfig, htmlRulesByName(o.(*schema.Set).List()), htmlRulesByName(n.(*schema.Set).List()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipLtmProfileHtmlRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileHtmlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading HTML Profile  " + name)
+	var obj ltmProfileHTML
+	found, err := getTmEntity(client, &obj, uriLtmProfileHTML, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve html Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading HTML Object:%+v ", obj)
+	if !found {
+		log.Printf("[WARN] html  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("content_detection", obj.ContentDetection)
+	_ = d.Set("content_selection", obj.ContentSelection)
+
+	known := htmlRulesByName(d.Get("content_rule").(*schema.Set).List())
+	var rules []interface{}
+	for _, ruleName := range obj.Rules {
+		ruleType := ""
+		if r, ok := known[ruleName]; ok {
+			ruleType = r["type"].(string)
+		}
+		r, err := getHTMLRule(client, ruleName, ruleType)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading html rule (%s): %s", ruleName, err))
+		}
+		if r != nil {
+			rules = append(rules, r)
+		}
+	}
+	if err := d.Set("content_rule", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving content_rule to state for html Profile (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipLtmProfileHtmlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Html Profile " + name)
+
+	err := client.DeleteHTMLProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete html Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	for _, item := range d.Get("content_rule").(*schema.Set).List() {
+		r := item.(map[string]interface{})
+		if err := deleteTmEntity(client, uriLtmHTMLRule, r["type"].(string), r["name"].(string)); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting html rule (%s): %s", r["name"], err))
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func getHTMLProfileConfig(d *schema.ResourceData, config *ltmProfileHTML) *ltmProfileHTML {
+	config.Partition = d.Get("partition").(string)
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.ContentDetection = d.Get("content_detection").(string)
+	config.ContentSelection = listToStringSlice(d.Get("content_selection").([]interface{}))
+	config.Rules = []string{}
+	for _, r := range d.Get("content_rule").(*schema.Set).List() {
+		config.Rules = append(config.Rules, r.(map[string]interface{})["name"].(string))
+	}
+	return config
+}
+
+// updateHTMLProfile modifies the profile and its content rules. New and
+// changed rules are written before the profile references them, rules that
+// went away are deleted once the profile no longer does. A rule that keeps its
+// name but changes type is detached and deleted first, as BIG-IP does not
+// allow two rules of the same name.
+func updateHTMLProfile(client *bigip.BigIP, name string, config *ltmProfileHTML, oldRules, newRules map[string]map[string]interface{}) error {
+	var stale, retyped []map[string]interface{}
+	kept := []string{}
+	for ruleName, r := range oldRules {
+		nr, ok := newRules[ruleName]
+		switch {
+		case !ok:
+			stale = append(stale, r)
+			kept = append(kept, ruleName)
+		case nr["type"] != r["type"]:
+			retyped = append(retyped, r)
+		default:
+			kept = append(kept, ruleName)
+		}
+	}
+	if len(retyped) > 0 {
+		sort.Strings(kept)
+		detach := struct {
+			Rules []string `json:"rules"`
+		}{kept}
+		if err := patchTmEntity(client, &detach, uriLtmProfileHTML, name); err != nil {
+			return fmt.Errorf("error modifying profile html (%s): %s", name, err)
+		}
+		for _, r := range retyped {
+			if err := deleteTmEntity(client, uriLtmHTMLRule, r["type"].(string), r["name"].(string)); err != nil {
+				return fmt.Errorf("error deleting html rule (%s): %s", r["name"], err)
+			}
+		}
+	}
+	for ruleName, r := range newRules {
+		or, ok := oldRules[ruleName]
+		if ok && htmlRuleEqual(or, r) {
+			continue
+		}
+		if err := putHTMLRule(client, r, !ok || or["type"] != r["type"]); err != nil {
+			return err
+		}
+	}
+
+	if err := patchTmEntity(client, config, uriLtmProfileHTML, name); err != nil {
+		return fmt.Errorf("error modifying profile html (%s): %s", name, err)
+	}
+
+	for _, r := range stale {
+		if err := deleteTmEntity(client, uriLtmHTMLRule, r["type"].(string), r["name"].(string)); err != nil {
+			return fmt.Errorf("error deleting html rule (%s): %s", r["name"], err)
+		}
+	}
+	return nil
+}
+
+func htmlRulesByName(rules []interface{}) map[string]map[string]interface{} {
+	byName := make(map[string]map[string]interface{}, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		byName[rule["name"].(string)] = rule
+	}
+	return byName
+}
+
+func htmlRuleEqual(a, b map[string]interface{}) bool {
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func putHTMLRule(client *bigip.BigIP, r map[string]interface{}, create bool) error {
+	name := r["name"].(string)
+	ruleType := r["type"].(string)
+	rule := &ltmHTMLRule{
+		Description: r["description"].(string),
+	}
+	// comment-* rules have neither match nor action properties.
+	match := ltmHTMLRuleMatch{
+		TagName:        r["match_tag_name"].(string),
+		AttributeName:  r["match_attribute_name"].(string),
+		AttributeValue: r["match_attribute_value"].(string),
+	}
+	if match != (ltmHTMLRuleMatch{}) {
+		rule.Match = &match
+	}
+	if text, attr := r["action_text"].(string), r["action_attribute_name"].(string); text != "" || attr != "" {
+		rule.Action = &ltmHTMLRuleAction{Text: text, AttributeName: attr}
+	}
+	var err error
+	if create {
+		rule.Name = name
+		err = createTmEntity(client, rule, uriLtmHTMLRule, ruleType)
+	} else {
+		err = modifyTmEntity(client, rule, uriLtmHTMLRule, ruleType, name)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing html rule (%s): %s", name, err)
+	}
+	return nil
+}
+
+// getHTMLRule reads an html rule of the given type, or looks it up across all
+// rule types when the type is not known yet (after an import).
+func getHTMLRule(client *bigip.BigIP, name, ruleType string) (map[string]interface{}, error) {
+	types := htmlRuleTypes
+	if ruleType != "" {
+		types = []string{ruleType}
+	}
+	for _, t := range types {
+		var rule ltmHTMLRule
+		found, err := getTmEntity(client, &rule, uriLtmHTMLRule, t, name)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		r := map[string]interface{}{
+			"name":                  name,
+			"type":                  t,
+			"description":           rule.Description,
+			"match_tag_name":        "",
+			"match_attribute_name":  "",
+			"match_attribute_value": "",
+			"action_text":           "",
+			"action_attribute_name": "",
+		}
+		if rule.Match != nil {
+			r["match_tag_name"] = rule.Match.TagName
+			r["match_attribute_name"] = rule.Match.AttributeName
+			r["match_attribute_value"] = rule.Match.AttributeValue
+		}
+		if rule.Action != nil {
+			r["action_text"] = rule.Action.Text
+			r["action_attribute_name"] = rule.Action.AttributeName
+		}
+		return r, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestHtmlName = fmt.Sprintf("/%s/test-html", TestPartition)
+
+func TestAccBigipLtmProfileHtml_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHtmlsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileHTMLConfig(TestHtmlName, `
+  content_rule {
+    name           = "/Common/test-html-append"
+    type           = "tag-append-html"
+    match_tag_name = "/head"
+    action_text    = "<script src=\"/rum.js\"></script>"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHtmlExists(TestHtmlName, []string{"/Common/test-html-append"}),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_html.test-html", "name", TestHtmlName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_html.test-html", "defaults_from", "/Common/html"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_html.test-html", "content_detection", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_html.test-html", "content_rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("bigip_ltm_profile_html.test-html", "content_rule.*", map[string]string{
+						"name":           "/Common/test-html-append",
+						"type":           "tag-append-html",
+						"match_tag_name": "/head",
+					}),
+				),
+			},
+			{
+				Config: getProfileHTMLConfig(TestHtmlName, `
+  content_rule {
+    name = "/Common/test-html-comments"
+    type = "comment-remove"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHtmlExists(TestHtmlName, []string{"/Common/test-html-comments"}),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_html.test-html", "content_rule.#", "1"),
+					testCheckHtmlRuleDestroyed("/Common/test-html-append", "tag-append-html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileHtml_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckHtmlsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileHTMLConfig(TestHtmlName, `
+  content_rule {
+    name = "/Common/test-html-comments"
+    type = "comment-remove"
+  }`),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_html.test-html",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckHtmlExists(name string, rules []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p ltmProfileHTML
+		found, err := getTmEntity(client, &p, uriLtmProfileHTML, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("html %s was not created ", name)
+		}
+		if fmt.Sprint(p.Rules) != fmt.Sprint(rules) {
+			return fmt.Errorf("html %s has rules %v, expected %v ", name, p.Rules, rules)
+		}
+		return nil
+	}
+}
+
+func testCheckHtmlRuleDestroyed(name, ruleType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		r, err := getHTMLRule(client, name, ruleType)
+		if err != nil {
+			return err
+		}
+		if r != nil {
+			return fmt.Errorf("html rule %s not destroyed ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckHtmlsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_html" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		html, err := client.GetHTMLProfile(name)
+		if err != nil {
+			return err
+		}
+		if html != nil {
+			return fmt.Errorf("html %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileHTMLConfig(profileName, rules string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_html" "test-html" {
+  name              = "%s"
+  defaults_from     = "/Common/html"
+  content_detection = "enabled"
+  content_selection = ["text/html", "text/xhtml"]
+%s
+}
+`, profileName, rules)
+}
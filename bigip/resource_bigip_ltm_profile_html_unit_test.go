@@ -0,0 +1,62 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateHTMLProfileRetypedRule(t *testing.T) {
+	setup()
+	defer teardown()
+	var requests []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{}`)
+	}
+	mux.HandleFunc("/mgmt/tm/ltm/profile/html/", record)
+	mux.HandleFunc("/mgmt/tm/ltm/html-rule/", record)
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	rules := func(typ string) map[string]map[string]interface{} {
+		d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileHtml().Schema, map[string]interface{}{
+			"name": "/Common/test-html",
+			"content_rule": []interface{}{
+				map[string]interface{}{"name": "banner", "type": typ, "match_tag_name": "/body", "action_text": "<p>hi</p>"},
+			},
+		})
+		return htmlRulesByName(d.Get("content_rule").(*schema.Set).List())
+	}
+	config := &ltmProfileHTML{Rules: []string{"banner"}}
+	config.Name = "/Common/test-html"
+
+	err := updateHTMLProfile(client, "/Common/test-html", config, rules("tag-append-html"), rules("tag-prepend-html"))
+	assert.NoError(t, err)
+	if assert.Len(t, requests, 4) {
+		assert.Equal(t, `PATCH /mgmt/tm/ltm/profile/html/~Common~test-html {"rules":[]}`, requests[0])
+		assert.Equal(t, "DELETE /mgmt/tm/ltm/html-rule/tag-append-html/banner ", requests[1])
+		assert.Regexp(t, `^POST /mgmt/tm/ltm/html-rule/tag-prepend-html `, requests[2])
+		assert.Regexp(t, `^PATCH /mgmt/tm/ltm/profile/html/~Common~test-html .*"rules":\["banner"\]`, requests[3])
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmProfileICAP = "ltm/profile/icap"
+
+// go-bigip has no ICAP profile API. The header fields are always sent, so
+// removing one from the configuration clears it on the device.
+type ltmProfileICAP struct {
+	Name           string `json:"name,omitempty"`
+	Partition      string `json:"partition,omitempty"`
+	DefaultsFrom   string `json:"defaultsFrom,omitempty"`
+	Description    string `json:"description"`
+	Uri            string `json:"uri,omitempty"`
+	HeaderFrom     string `json:"headerFrom"`
+	Host           string `json:"host"`
+	Referer        string `json:"referer"`
+	UserAgent      string `json:"userAgent"`
+	RequestHeader  string `json:"requestHeader"`
+	ResponseHeader string `json:"responseHeader"`
+	PreviewLength  int    `json:"previewLength"`
+}
+
+func resourceBigipLtmProfileIcap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileIcapCreate,
+		UpdateContext: resourceBigipLtmProfileIcapUpdate,
+		ReadContext:   resourceBigipLtmProfileIcapRead,
+		DeleteContext: resourceBigipLtmProfileIcapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the ICAP Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent icap profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"uri": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ICAP URI sent to the server, for example `icap://${SERVER_IP}:${SERVER_PORT}/avscan`",
+			},
+			"header_from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the `From` header of ICAP requests",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the `Host` header of ICAP requests",
+			},
+			"referer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the `Referer` header of ICAP requests",
+			},
+			"user_agent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value of the `User-Agent` header of ICAP requests",
+			},
+			"request_header": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Replacement HTTP request header sent to the ICAP server",
+			},
+			"response_header": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Replacement HTTP response header sent to the ICAP server",
+			},
+			"preview_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 51200),
+				Description:  "Number of bytes of the body sent in the ICAP preview",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileIcapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	icapConfig := getIcapProfileConfig(d)
+	icapConfig.Name = name
+	log.Println("[INFO] Creating ICAP profile")
+	err := createTmEntity(client, icapConfig, uriLtmProfileICAP)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create icap Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileIcapRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileIcapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating ICAP Profile " + name)
+	icapConfig := getIcapProfileConfig(d)
+	err := patchTmEntity(client, icapConfig, uriLtmProfileICAP, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile icap (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileIcapRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileIcapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading ICAP Profile  " + name)
+	var obj ltmProfileICAP
+	found, err := getTmEntity(client, &obj, uriLtmProfileICAP, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve icap Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] icap  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("uri", obj.Uri)
+	_ = d.Set("header_from", obj.HeaderFrom)
+	_ = d.Set("host", obj.Host)
+	_ = d.Set("referer", obj.Referer)
+	_ = d.Set("user_agent", obj.UserAgent)
+	_ = d.Set("request_header", obj.RequestHeader)
+	_ = d.Set("response_header", obj.ResponseHeader)
+	_ = d.Set("preview_length", obj.PreviewLength)
+	return nil
+}
+
+func resourceBigipLtmProfileIcapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting ICAP Profile " + name)
+
+	err := deleteTmEntity(client, uriLtmProfileICAP, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete icap Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getIcapProfileConfig(d *schema.ResourceData) *ltmProfileICAP {
+	return &ltmProfileICAP{
+		Partition:      d.Get("partition").(string),
+		DefaultsFrom:   d.Get("defaults_from").(string),
+		Description:    d.Get("description").(string),
+		Uri:            d.Get("uri").(string),
+		HeaderFrom:     d.Get("header_from").(string),
+		Host:           d.Get("host").(string),
+		Referer:        d.Get("referer").(string),
+		UserAgent:      d.Get("user_agent").(string),
+		RequestHeader:  d.Get("request_header").(string),
+		ResponseHeader: d.Get("response_header").(string),
+		PreviewLength:  d.Get("preview_length").(int),
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestIcapName = fmt.Sprintf("/%s/test-icap", TestPartition)
+
+func TestAccBigipLtmProfileIcap_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckIcapsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileIcapConfig(TestIcapName, "avscan", 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIcapExists(TestIcapName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "name", TestIcapName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "defaults_from", "/Common/icap"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "uri", "icap://${SERVER_IP}:${SERVER_PORT}/avscan"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "preview_length", "1024"),
+				),
+			},
+			{
+				Config: getProfileIcapConfig(TestIcapName, "dlp", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIcapExists(TestIcapName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "uri", "icap://${SERVER_IP}:${SERVER_PORT}/dlp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_icap.test-icap", "preview_length", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileIcap_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckIcapsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileIcapConfig(TestIcapName, "avscan", 1024),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_icap.test-icap",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckIcapExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var p ltmProfileICAP
+		found, err := getTmEntity(client, &p, uriLtmProfileICAP, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("icap %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckIcapsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_icap" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		var p ltmProfileICAP
+		found, err := getTmEntity(client, &p, uriLtmProfileICAP, name)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("icap %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileIcapConfig(profileName, service string, previewLength int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_icap" "test-icap" {
+  name           = "%s"
+  defaults_from  = "/Common/icap"
+  uri            = "icap://$${SERVER_IP}:$${SERVER_PORT}/%s"
+  header_from    = "admin@example.com"
+  preview_length = %d
+}
+`, profileName, service, previewLength)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmProfileRequestAdapt = "ltm/profile/request-adapt"
+
+func resourceBigipLtmProfileRequestAdapt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileRequestAdaptCreate,
+		UpdateContext: resourceBigipLtmProfileRequestAdaptUpdate,
+		ReadContext:   resourceBigipLtmProfileRequestAdaptRead,
+		DeleteContext: resourceBigipLtmProfileRequestAdaptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Request Adapt Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent requestadapt profile",
+			},
+			"enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Send requests to the adaptation server",
+			},
+			"internal_virtual": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Internal virtual server that forwards requests to the ICAP servers",
+			},
+			"preview_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of bytes of the HTTP body sent to the ICAP server as a preview",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Milliseconds to wait for the adaptation server, 0 waits indefinitely",
+			},
+			"service_down_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "drop", "reset"}, false),
+				Description:  "Action taken when the internal virtual server is unavailable",
+			},
+			"allow_http_10": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Adapt HTTP/1.0 requests as well",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileRequestAdaptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	requestAdaptConfig := getAdaptProfileConfig(d)
+	requestAdaptConfig.Name = name
+	log.Println("[INFO] Creating RequestAdapt profile")
+	err := createTmEntity(client, requestAdaptConfig, uriLtmProfileRequestAdapt)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create requestadapt Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileRequestAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileRequestAdaptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating RequestAdapt Profile " + name)
+	err := patchTmEntity(client, getAdaptProfileConfig(d), uriLtmProfileRequestAdapt, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile requestadapt (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileRequestAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileRequestAdaptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading RequestAdapt Profile  " + name)
+	obj, err := client.GetRequestAdaptProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve requestadapt Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading RequestAdapt Object:%+v ", obj)
+	if obj == nil {
+		log.Printf("[WARN] requestadapt  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("enabled", obj.Enabled)
+	_ = d.Set("preview_size", obj.PreviewSize)
+	_ = d.Set("timeout", obj.Timeout)
+	_ = d.Set("service_down_action", obj.ServiceDownAction)
+	_ = d.Set("allow_http_10", obj.AllowHttp10)
+	_ = d.Set("internal_virtual", noneToEmpty(obj.InternalVirtual))
+	return nil
+}
+
+func resourceBigipLtmProfileRequestAdaptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting RequestAdapt Profile " + name)
+
+	err := client.DeleteRequestAdaptProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete requestadapt Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// ltmProfileAdapt is the body sent for request and response adapt profiles.
+// The go-bigip types drop a previewSize or timeout of 0 and an empty
+// internalVirtual, so those could never be set back on the device.
+type ltmProfileAdapt struct {
+	Name              string `json:"name,omitempty"`
+	Partition         string `json:"partition,omitempty"`
+	DefaultsFrom      string `json:"defaultsFrom,omitempty"`
+	AllowHttp10       string `json:"allowHttp_10,omitempty"`
+	Enabled           string `json:"enabled,omitempty"`
+	InternalVirtual   string `json:"internalVirtual"`
+	PreviewSize       int    `json:"previewSize"`
+	ServiceDownAction string `json:"serviceDownAction,omitempty"`
+	Timeout           int    `json:"timeout"`
+}
+
+func getAdaptProfileConfig(d *schema.ResourceData) *ltmProfileAdapt {
+	return &ltmProfileAdapt{
+		Partition:         d.Get("partition").(string),
+		DefaultsFrom:      d.Get("defaults_from").(string),
+		Enabled:           d.Get("enabled").(string),
+		InternalVirtual:   emptyToNone(d.Get("internal_virtual").(string)),
+		PreviewSize:       d.Get("preview_size").(int),
+		Timeout:           d.Get("timeout").(int),
+		ServiceDownAction: d.Get("service_down_action").(string),
+		AllowHttp10:       d.Get("allow_http_10").(string),
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestRequestAdaptName = fmt.Sprintf("/%s/test-requestadapt", TestPartition)
+
+func TestAccBigipLtmProfileRequestAdapt_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckRequestAdaptsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileRequestAdaptConfig(TestRequestAdaptName, 1024, "ignore"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckRequestAdaptExists(TestRequestAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "name", TestRequestAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "defaults_from", "/Common/requestadapt"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "preview_size", "1024"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "service_down_action", "ignore"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "allow_http_10", "yes"),
+				),
+			},
+			{
+				Config: getProfileRequestAdaptConfig(TestRequestAdaptName, 2048, "reset"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckRequestAdaptExists(TestRequestAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "preview_size", "2048"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_request_adapt.test-requestadapt", "service_down_action", "reset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileRequestAdapt_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckRequestAdaptsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileRequestAdaptConfig(TestRequestAdaptName, 1024, "ignore"),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_request_adapt.test-requestadapt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckRequestAdaptExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetRequestAdaptProfile(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("requestadapt %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckRequestAdaptsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_request_adapt" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		requestAdapt, err := client.GetRequestAdaptProfile(name)
+		if err != nil {
+			return err
+		}
+		if requestAdapt != nil {
+			return fmt.Errorf("requestadapt %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileRequestAdaptConfig(profileName string, previewSize int, serviceDownAction string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_request_adapt" "test-requestadapt" {
+  name                = "%s"
+  defaults_from       = "/Common/requestadapt"
+  preview_size        = %d
+  timeout             = 5000
+  service_down_action = "%s"
+  allow_http_10       = "yes"
+}
+`, profileName, previewSize, serviceDownAction)
+}
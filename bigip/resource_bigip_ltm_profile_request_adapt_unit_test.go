@@ -0,0 +1,34 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAdaptProfileConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceBigipLtmProfileRequestAdapt().Schema, map[string]interface{}{
+		"name":         "/Common/test-requestadapt",
+		"preview_size": 0,
+		"timeout":      0,
+	})
+	body, err := json.Marshal(getAdaptProfileConfig(d))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"internalVirtual":"none","previewSize":0,"timeout":0}`, string(body))
+
+	d = schema.TestResourceDataRaw(t, resourceBigipLtmProfileResponseAdapt().Schema, map[string]interface{}{
+		"name":             "/Common/test-responseadapt",
+		"internal_virtual": "/Common/icap-vs",
+		"preview_size":     1024,
+	})
+	body, err = json.Marshal(getAdaptProfileConfig(d))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"internalVirtual":"/Common/icap-vs","previewSize":1024,"timeout":0}`, string(body))
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriLtmProfileResponseAdapt = "ltm/profile/response-adapt"
+
+func resourceBigipLtmProfileResponseAdapt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileResponseAdaptCreate,
+		UpdateContext: resourceBigipLtmProfileResponseAdaptUpdate,
+		ReadContext:   resourceBigipLtmProfileResponseAdaptRead,
+		DeleteContext: resourceBigipLtmProfileResponseAdaptDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Response Adapt Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent responseadapt profile",
+			},
+			"enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Send responses to the adaptation server",
+			},
+			"internal_virtual": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Internal virtual server that forwards responses to the ICAP servers",
+			},
+			"preview_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of bytes of the HTTP response body sent to the ICAP server as a preview",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Milliseconds to wait for the adaptation server, 0 waits indefinitely",
+			},
+			"service_down_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "drop", "reset"}, false),
+				Description:  "Action taken when the internal virtual server is unavailable",
+			},
+			"allow_http_10": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"yes", "no"}, false),
+				Description:  "Adapt HTTP/1.0 responses as well",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileResponseAdaptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	responseAdaptConfig := getAdaptProfileConfig(d)
+	responseAdaptConfig.Name = name
+	log.Println("[INFO] Creating ResponseAdapt profile")
+	err := createTmEntity(client, responseAdaptConfig, uriLtmProfileResponseAdapt)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create responseadapt Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileResponseAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileResponseAdaptUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating ResponseAdapt Profile " + name)
+	err := patchTmEntity(client, getAdaptProfileConfig(d), uriLtmProfileResponseAdapt, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile responseadapt (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileResponseAdaptRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileResponseAdaptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading ResponseAdapt Profile  " + name)
+	obj, err := client.GetResponseAdaptProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve responseadapt Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading ResponseAdapt Object:%+v ", obj)
+	if obj == nil {
+		log.Printf("[WARN] responseadapt  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("enabled", obj.Enabled)
+	_ = d.Set("preview_size", obj.PreviewSize)
+	_ = d.Set("timeout", obj.Timeout)
+	_ = d.Set("service_down_action", obj.ServiceDownAction)
+	_ = d.Set("allow_http_10", obj.AllowHttp10)
+	_ = d.Set("internal_virtual", noneToEmpty(obj.InternalVirtual))
+	return nil
+}
+
+func resourceBigipLtmProfileResponseAdaptDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting ResponseAdapt Profile " + name)
+
+	err := client.DeleteResponseAdaptProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete responseadapt Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestResponseAdaptName = fmt.Sprintf("/%s/test-responseadapt", TestPartition)
+
+func TestAccBigipLtmProfileResponseAdapt_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckResponseAdaptsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileResponseAdaptConfig(TestResponseAdaptName, 1024, "ignore"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResponseAdaptExists(TestResponseAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "name", TestResponseAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "defaults_from", "/Common/responseadapt"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "preview_size", "1024"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "service_down_action", "ignore"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "allow_http_10", "yes"),
+				),
+			},
+			{
+				Config: getProfileResponseAdaptConfig(TestResponseAdaptName, 2048, "reset"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResponseAdaptExists(TestResponseAdaptName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "preview_size", "2048"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_response_adapt.test-responseadapt", "service_down_action", "reset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileResponseAdapt_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckResponseAdaptsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileResponseAdaptConfig(TestResponseAdaptName, 1024, "ignore"),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_response_adapt.test-responseadapt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckResponseAdaptExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetResponseAdaptProfile(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("responseadapt %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckResponseAdaptsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_response_adapt" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		responseAdapt, err := client.GetResponseAdaptProfile(name)
+		if err != nil {
+			return err
+		}
+		if responseAdapt != nil {
+			return fmt.Errorf("responseadapt %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileResponseAdaptConfig(profileName string, previewSize int, serviceDownAction string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_response_adapt" "test-responseadapt" {
+  name                = "%s"
+  defaults_from       = "/Common/responseadapt"
+  preview_size        = %d
+  timeout             = 5000
+  service_down_action = "%s"
+  allow_http_10       = "yes"
+}
+`, profileName, previewSize, serviceDownAction)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBigipLtmProfileUdp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileUdpCreate,
+		UpdateContext: resourceBigipLtmProfileUdpUpdate,
+		ReadContext:   resourceBigipLtmProfileUdpRead,
+		DeleteContext: resourceBigipLtmProfileUdpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the UDP Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent udp profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"idle_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of seconds (default 60) a flow may remain idle before it becomes eligible for deletion, or `immediate` / `indefinite`",
+			},
+			"datagram_load_balancing": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Load balance each datagram individually instead of every flow",
+			},
+			"allow_no_payload": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Allow datagrams without payload to pass",
+			},
+			"buffer_max_bytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum bytes buffered before the oldest datagrams are dropped",
+			},
+			"buffer_max_packets": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum datagrams buffered before the oldest datagrams are dropped",
+			},
+			"ip_df_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pmtu", "preserve", "set", "clear"}, false),
+				Description:  "Handling of the Don't Fragment bit on outgoing packets",
+			},
+			"ip_tos_to_client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "IP Type of Service set on packets sent to the client, `pass-through`, `mimic` or a value from 0 to 255",
+			},
+			"link_qos_to_client": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Link QoS set on packets sent to the client, `pass-through` or a value from 0 to 7",
+			},
+			"no_checksum": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Disable checksum processing",
+			},
+			"proxy_mss": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Use the MSS of the client side on the server side",
+			},
+			"send_buffer_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Send buffer size in bytes (default 655350)",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileUdpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	udpConfig := &bigip.UdpProfile{
+		Name: name,
+	}
+	udpProfileConfig := getUDPProfileConfig(d, udpConfig)
+	log.Println("[INFO] Creating UDP profile")
+	err := client.AddUDPProfile(udpProfileConfig)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create udp Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileUdpRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileUdpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating UDP Profile " + name)
+	udpConfig := &bigip.UdpProfile{
+		Name: name,
+	}
+	udpProfileConfig := getUDPProfileConfig(d, udpConfig)
+	err := client.ModifyUDPProfile(name, udpProfileConfig)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile udp (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileUdpRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileUdpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading UDP Profile  " + name)
+	obj, err := client.GetUDPProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve udp Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading UDP Object:%+v ", obj)
+	if obj == nil {
+		log.Printf("[WARN] udp  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("idle_timeout", obj.IdleTimeout)
+	_ = d.Set("datagram_load_balancing", obj.DatagramLoadBalancing)
+	_ = d.Set("allow_no_payload", obj.AllowNoPayload)
+	_ = d.Set("buffer_max_bytes", obj.BufferMaxBytes)
+	_ = d.Set("buffer_max_packets", obj.BufferMaxPackets)
+	_ = d.Set("ip_df_mode", obj.IPDfMode)
+	_ = d.Set("ip_tos_to_client", obj.IPTosToClient)
+	_ = d.Set("link_qos_to_client", obj.LinkQosToClient)
+	_ = d.Set("no_checksum", obj.NoChecksum)
+	_ = d.Set("proxy_mss", obj.ProxyMss)
+	_ = d.Set("send_buffer_size", obj.SendBufferSize)
+	return nil
+}
+
+func resourceBigipLtmProfileUdpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting Udp Profile " + name)
+
+	err := client.DeleteUDPProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete udp Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getUDPProfileConfig(d *schema.ResourceData, config *bigip.UdpProfile) *bigip.UdpProfile {
+	config.Partition = d.Get("partition").(string)
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.IdleTimeout = d.Get("idle_timeout").(string)
+	config.DatagramLoadBalancing = d.Get("datagram_load_balancing").(string)
+	config.AllowNoPayload = d.Get("allow_no_payload").(string)
+	config.BufferMaxBytes = d.Get("buffer_max_bytes").(int)
+	config.BufferMaxPackets = d.Get("buffer_max_packets").(int)
+	config.IPDfMode = d.Get("ip_df_mode").(string)
+	config.IPTosToClient = d.Get("ip_tos_to_client").(string)
+	config.LinkQosToClient = d.Get("link_qos_to_client").(string)
+	config.NoChecksum = d.Get("no_checksum").(string)
+	config.ProxyMss = d.Get("proxy_mss").(string)
+	config.SendBufferSize = d.Get("send_buffer_size").(int)
+	return config
+}
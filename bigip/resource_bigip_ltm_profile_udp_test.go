@@ -0,0 +1,113 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestUdpName = fmt.Sprintf("/%s/test-udp", TestPartition)
+
+func TestAccBigipLtmProfileUdp_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckUdpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileUDPConfig(TestUdpName, "120", "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUdpExists(TestUdpName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "name", TestUdpName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "defaults_from", "/Common/udp"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "idle_timeout", "120"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "datagram_load_balancing", "enabled"),
+				),
+			},
+			{
+				Config: getProfileUDPConfig(TestUdpName, "indefinite", "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckUdpExists(TestUdpName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "idle_timeout", "indefinite"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_udp.test-udp", "datagram_load_balancing", "disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileUdp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckUdpsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileUDPConfig(TestUdpName, "120", "enabled"),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_udp.test-udp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckUdpExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetUDPProfile(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("udp %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckUdpsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_udp" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		udp, err := client.GetUDPProfile(name)
+		if err != nil {
+			return err
+		}
+		if udp != nil {
+			return fmt.Errorf("udp %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileUDPConfig(profileName, idleTimeout, datagramLB string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_udp" "test-udp" {
+  name                    = "%s"
+  defaults_from           = "/Common/udp"
+  idle_timeout            = "%s"
+  datagram_load_balancing = "%s"
+}
+`, profileName, idleTimeout, datagramLB)
+}
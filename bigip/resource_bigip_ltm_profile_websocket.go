@@ -0,0 +1,193 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceBigipLtmProfileWebsocket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipLtmProfileWebsocketCreate,
+		UpdateContext: resourceBigipLtmProfileWebsocketUpdate,
+		ReadContext:   resourceBigipLtmProfileWebsocketRead,
+		DeleteContext: resourceBigipLtmProfileWebsocketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the WebSocket Profile",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "name of partition",
+			},
+			"defaults_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Use the parent websocket profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"masking": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"preserve", "remask", "selective", "unmask"}, false),
+				Description:  "How client frame masking is handled: `preserve` the client mask, `remask` with a new key, `selective` (only when the payload is modified) or `unmask` towards the server",
+			},
+			"compress_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"preserved", "typed"}, false),
+				Description:  "Compression negotiation, `preserved` keeps the client offer, `typed` applies this profile's settings",
+			},
+			"compression": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Enable the per-message deflate extension",
+			},
+			"window_bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(8, 15),
+				Description:  "Size of the LZ77 sliding window used for compression, as a power of two",
+			},
+			"no_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+				Description:  "Forward frames without waiting for a full buffer",
+			},
+			"payload_processing_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "message", "frame"}, false),
+				Description:  "Unit in which the payload is handed to the payload protocol profile",
+			},
+			"payload_protocol_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Profile used to parse the WebSocket payload",
+			},
+		},
+	}
+}
+
+func resourceBigipLtmProfileWebsocketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	websocketConfig := &bigip.WebsocketProfile{
+		Name: name,
+	}
+	websocketProfileConfig := getWebsocketProfileConfig(d, websocketConfig)
+	log.Println("[INFO] Creating WebSocket profile")
+	err := client.AddWebsocketProfile(websocketProfileConfig)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create websocket Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipLtmProfileWebsocketRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileWebsocketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Updating WebSocket Profile " + name)
+	websocketConfig := &bigip.WebsocketProfile{
+		Name: name,
+	}
+	websocketProfileConfig := getWebsocketProfileConfig(d, websocketConfig)
+	err := client.ModifyWebsocketProfile(name, websocketProfileConfig)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error modifying profile websocket (%s): %s", name, err))
+	}
+	return resourceBigipLtmProfileWebsocketRead(ctx, d, meta)
+}
+
+func resourceBigipLtmProfileWebsocketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Reading WebSocket Profile  " + name)
+	obj, err := client.GetWebsocketProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to retrieve websocket Profile  (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Reading WebSocket Object:%+v ", obj)
+	if obj == nil {
+		log.Printf("[WARN] websocket  Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("defaults_from", obj.DefaultsFrom)
+	_ = d.Set("description", obj.Description)
+	_ = d.Set("masking", obj.Masking)
+	_ = d.Set("compress_mode", obj.CompressMode)
+	_ = d.Set("compression", obj.Compression)
+	_ = d.Set("window_bits", obj.WindowBits)
+	_ = d.Set("no_delay", obj.NoDelay)
+	_ = d.Set("payload_processing_mode", obj.PayloadProcessingMode)
+	_ = d.Set("payload_protocol_profile", obj.PayloadProtocolProfile)
+	return nil
+}
+
+func resourceBigipLtmProfileWebsocketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Id()
+	log.Println("[INFO] Deleting WebSocket Profile " + name)
+
+	err := client.DeleteWebsocketProfile(name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete websocket Profile (%s) (%v)", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getWebsocketProfileConfig(d *schema.ResourceData, config *bigip.WebsocketProfile) *bigip.WebsocketProfile {
+	config.Partition = d.Get("partition").(string)
+	config.DefaultsFrom = d.Get("defaults_from").(string)
+	config.Description = d.Get("description").(string)
+	config.Masking = d.Get("masking").(string)
+	config.CompressMode = d.Get("compress_mode").(string)
+	config.Compression = d.Get("compression").(string)
+	config.WindowBits = d.Get("window_bits").(int)
+	config.NoDelay = d.Get("no_delay").(string)
+	config.PayloadProcessingMode = d.Get("payload_processing_mode").(string)
+	config.PayloadProtocolProfile = d.Get("payload_protocol_profile").(string)
+	return config
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestWebsocketName = fmt.Sprintf("/%s/test-websocket", TestPartition)
+
+func TestAccBigipLtmProfileWebsocket_create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWebsocketsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileWebsocketConfig(TestWebsocketName, "unmask"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWebsocketExists(TestWebsocketName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "name", TestWebsocketName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "defaults_from", "/Common/websocket"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "masking", "unmask"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "compression", "enabled"),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "window_bits", "10"),
+				),
+			},
+			{
+				Config: getProfileWebsocketConfig(TestWebsocketName, "preserve"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckWebsocketExists(TestWebsocketName),
+					resource.TestCheckResourceAttr("bigip_ltm_profile_websocket.test-websocket", "masking", "preserve"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipLtmProfileWebsocket_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckWebsocketsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: getProfileWebsocketConfig(TestWebsocketName, "unmask"),
+			},
+			{
+				ResourceName:            "bigip_ltm_profile_websocket.test-websocket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partition"},
+			},
+		},
+	})
+}
+
+func testCheckWebsocketExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		p, err := client.GetWebsocketProfile(name)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			return fmt.Errorf("websocket %s was not created ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckWebsocketsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_ltm_profile_websocket" {
+			continue
+		}
+
+		name := rs.Primary.ID
+		websocket, err := client.GetWebsocketProfile(name)
+		if err != nil {
+			return err
+		}
+		if websocket != nil {
+			return fmt.Errorf("websocket %s not destroyed ", name)
+		}
+	}
+	return nil
+}
+
+func getProfileWebsocketConfig(profileName, masking string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_profile_websocket" "test-websocket" {
+  name          = "%s"
+  defaults_from = "/Common/websocket"
+  masking       = "%s"
+  compress_mode = "typed"
+  compression   = "enabled"
+  window_bits   = 10
+}
+`, profileName, masking)
+}
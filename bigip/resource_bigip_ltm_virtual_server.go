@@ -8,6 +8,7 @@ package bigip
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -255,6 +256,11 @@ func resourceBigipLtmVirtualServer() *schema.Resource {
 				Computed:    true,
 				Description: "Applies the specified AFM policy to the virtual in an enforcing way,when creating a new virtual, if this parameter is not specified, the enforced is disabled.this should be in full path ex: `/Common/afm-test-policy`",
 			},
+			"ip_intelligence_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "IP Intelligence policy enforced on the virtual server, in full path ex: `/Common/ipi-policy`",
+			},
 		},
 	}
 }
@@ -288,6 +294,9 @@ func resourceBigipLtmVirtualServerCreate(ctx context.Context, d *schema.Resource
 
 	name := d.Get("name").(string)
 	log.Println("[INFO] Creating virtual server " + name)
+	if err := validatePersistenceProfiles(client, setToStringSlice(d.Get("persistence_profiles").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
 	pss := &bigip.VirtualServer{
 		Name: name,
 	}
@@ -298,6 +307,11 @@ func resourceBigipLtmVirtualServerCreate(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	d.SetId(name)
+	if policy, ok := d.GetOk("ip_intelligence_policy"); ok {
+		if err := setVirtualServerIPIntelligencePolicy(client, name, policy.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	if !client.Teem {
 		id := uuid.New()
 		uniqueID := id.String()
@@ -429,6 +443,13 @@ func resourceBigipLtmVirtualServerRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("translate_address", vs.TranslateAddress)
 	_ = d.Set("translate_port", vs.TranslatePort)
 	_ = d.Set("firewall_enforced_policy", vs.FwEnforcedPolicy)
+	// go-bigip's VirtualServer has no ipIntelligencePolicy, it is read on its
+	// own so policies attached outside of Terraform show up in the plan.
+	var ipi virtualServerIPIntelligence
+	if _, err := getTmEntity(client, &ipi, "ltm/virtual", tmPath(name)+"?$select=ipIntelligencePolicy"); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("ip_intelligence_policy", noneToEmpty(ipi.IPIntelligencePolicy))
 
 	if len(vs.PersistenceProfiles) > 0 {
 		default_persistence := fmt.Sprintf("/%s/%s", vs.PersistenceProfiles[0].Partition, vs.PersistenceProfiles[0].Name)
@@ -485,11 +506,22 @@ func resourceBigipLtmVirtualServerUpdate(ctx context.Context, d *schema.Resource
 		Name: name,
 	}
 	log.Println("[INFO] Updating virtual server " + name)
+	if d.HasChange("persistence_profiles") {
+		if err := validatePersistenceProfiles(client, setToStringSlice(d.Get("persistence_profiles").(*schema.Set))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	config := getVirtualServerConfig(d, pss)
 	err := client.ModifyVirtualServer(name, config)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if d.HasChange("ip_intelligence_policy") {
+		policy := emptyToNone(d.Get("ip_intelligence_policy").(string))
+		if err := setVirtualServerIPIntelligencePolicy(client, name, policy); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceBigipLtmVirtualServerRead(ctx, d, meta)
 }
 
@@ -618,3 +650,91 @@ func getVirtualServerConfig(d *schema.ResourceData, config *bigip.VirtualServer)
 	}
 	return config
 }
+
+// go-bigip's VirtualServer has no IP Intelligence policy field.
+type virtualServerIPIntelligence struct {
+	IPIntelligencePolicy string `json:"ipIntelligencePolicy"`
+}
+
+func setVirtualServerIPIntelligencePolicy(client *bigip.BigIP, name, policy string) error {
+	err := patchTmEntity(client, &virtualServerIPIntelligence{IPIntelligencePolicy: policy}, "ltm/virtual", name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to set IP Intelligence Policy (%s) on Virtual Server (%s) (%v)", policy, name, err)
+	}
+	return err
+}
+
+type persistenceCollection struct {
+	Items []persistenceCollectionItem `json:"items"`
+}
+
+type persistenceCollectionItem struct {
+	FullPath  string `json:"fullPath"`
+	Reference struct {
+		Link string `json:"link"`
+	} `json:"reference"`
+	Items []persistenceCollectionItem `json:"items"`
+}
+
+// validatePersistenceProfiles makes sure every referenced persistence profile
+// exists, whatever its type, before the virtual server is sent to BIG-IP. The
+// profiles of all types are listed with one expanded ltm/persistence request.
+func validatePersistenceProfiles(client *bigip.BigIP, profiles []string) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	existing, err := getPersistenceProfileNames(client)
+	if err != nil {
+		return fmt.Errorf("error listing persistence profiles: %v", err)
+	}
+	for _, name := range profiles {
+		if !existing[name] {
+			return fmt.Errorf("persistence profile %s does not exist", name)
+		}
+	}
+	return nil
+}
+
+func getPersistenceProfileNames(client *bigip.BigIP) (map[string]bool, error) {
+	names := make(map[string]bool)
+	err := collectPersistenceProfiles(client, "ltm/persistence?expandSubcollections=true", names)
+	return names, err
+}
+
+// collectPersistenceProfiles adds the profiles of the collection to names.
+// Type collections BIG-IP did not expand are only returned as a reference and
+// are fetched on their own.
+func collectPersistenceProfiles(client *bigip.BigIP, path string, names map[string]bool) error {
+	resp, err := tmRequest(client, "get", nil, path)
+	if err != nil {
+		return err
+	}
+	var collection persistenceCollection
+	if err := json.Unmarshal(resp, &collection); err != nil {
+		return err
+	}
+	return addPersistenceProfiles(client, collection.Items, names)
+}
+
+func addPersistenceProfiles(client *bigip.BigIP, items []persistenceCollectionItem, names map[string]bool) error {
+	for _, item := range items {
+		switch {
+		case item.FullPath != "":
+			names[item.FullPath] = true
+		case len(item.Items) > 0:
+			if err := addPersistenceProfiles(client, item.Items, names); err != nil {
+				return err
+			}
+		case item.Reference.Link != "":
+			_, path, ok := strings.Cut(item.Reference.Link, "/mgmt/tm/")
+			if !ok {
+				continue
+			}
+			path, _, _ = strings.Cut(path, "?")
+			if err := collectPersistenceProfiles(client, path, names); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"regexp"
 	"testing"
 
 	bigip "github.com/f5devcentral/go-bigip"
@@ -920,6 +921,51 @@ func TestAccBigipLtmVirtualServer_PersistProfileDeletion(t *testing.T) {
 	})
 }
 
+func TestAccBigipLtmVirtualServer_PersistenceProfileExists(t *testing.T) {
+	vsName := "test-vs-sip-persist"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckVSsDestroyed,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config:      testVSCreateWithPersistenceProfile(vsName, `"/Common/test-sip-persist-missing"`),
+				ExpectError: regexp.MustCompile("persistence profile /Common/test-sip-persist-missing does not exist"),
+			},
+			{
+				Config: testVSCreateWithPersistenceProfile(vsName, "bigip_ltm_persistence_profile_sip.test-sip.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckVSExists(vsName),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "persistence_profiles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("bigip_ltm_virtual_server.test-vs", "persistence_profiles.*", "/Common/test-sip-persist"),
+				),
+			},
+		},
+	})
+}
+
+func testVSCreateWithPersistenceProfile(name, profile string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_persistence_profile_sip" "test-sip" {
+  name     = "/Common/test-sip-persist"
+  sip_info = "Call-ID"
+}
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name                 = "/Common/%s"
+  destination          = "192.168.50.3"
+  ip_protocol          = "udp"
+  port                 = 5060
+  profiles             = ["/Common/udp", "/Common/sip"]
+  persistence_profiles = [%s]
+}
+`, name, profile)
+}
+
 func testVSWithoutPersistence(name string) string {
 	return fmt.Sprintf(`
 resource "bigip_ltm_virtual_server" "test-vs" {
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePersistenceProfiles(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	mux.HandleFunc("/mgmt/tm/ltm/persistence", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("expandSubcollections"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[
+{"reference":{"link":"https://localhost/mgmt/tm/ltm/persistence/sip?ver=16.1.0"},"items":[{"name":"sip_trunk","fullPath":"/Common/sip_trunk","sipInfo":"Call-ID"}]},
+{"reference":{"link":"https://localhost/mgmt/tm/ltm/persistence/host?ver=16.1.0"}}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/ltm/persistence/host", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"host","fullPath":"/Common/host"}]}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	assert.NoError(t, validatePersistenceProfiles(client, []string{"/Common/sip_trunk", "/Common/host"}))
+	assert.Equal(t, 2, requests)
+	err := validatePersistenceProfiles(client, []string{"/Common/sip_trunk", "/Common/missing"})
+	assert.ErrorContains(t, err, "persistence profile /Common/missing does not exist")
+}
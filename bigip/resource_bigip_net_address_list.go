@@ -0,0 +1,167 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriNetAddressList = "net/address-list"
+
+type netAddressList struct {
+	Name         string        `json:"name,omitempty"`
+	FullPath     string        `json:"fullPath,omitempty"`
+	Description  string        `json:"description"`
+	Addresses    []netListItem `json:"addresses"`
+	Fqdns        []netListItem `json:"fqdns"`
+	AddressLists []netListItem `json:"addressLists"`
+}
+
+// Address and port list members are objects that only carry a name.
+type netListItem struct {
+	Name string `json:"name"`
+}
+
+func resourceBigipNetAddressList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipNetAddressListCreate,
+		ReadContext:   resourceBigipNetAddressListRead,
+		UpdateContext: resourceBigipNetAddressListUpdate,
+		DeleteContext: resourceBigipNetAddressListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Address List",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"addresses": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Addresses, subnets or address ranges, e.g. `10.1.1.1`, `10.0.0.0/8` or `10.1.1.1-10.1.1.9`",
+			},
+			"fqdns": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Fully qualified domain names, resolved by BIG-IP",
+			},
+			"address_lists": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Other address lists included in this one",
+			},
+		},
+	}
+}
+
+func resourceBigipNetAddressListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Address List:%+v ", name)
+
+	config := getNetAddressListConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriNetAddressList)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Address List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipNetAddressListRead(ctx, d, meta)
+}
+
+func resourceBigipNetAddressListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Address List " + name)
+
+	var list netAddressList
+	found, err := getTmEntity(client, &list, uriNetAddressList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Address List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Address List (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", list.FullPath)
+	_ = d.Set("description", list.Description)
+	_ = d.Set("addresses", netListItemNames(list.Addresses))
+	_ = d.Set("fqdns", netListItemNames(list.Fqdns))
+	_ = d.Set("address_lists", netListItemNames(list.AddressLists))
+	return nil
+}
+
+func resourceBigipNetAddressListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Address List:%+v ", name)
+
+	err := patchTmEntity(client, getNetAddressListConfig(d), uriNetAddressList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Address List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipNetAddressListRead(ctx, d, meta)
+}
+
+func resourceBigipNetAddressListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Address List " + name)
+
+	err := deleteTmEntity(client, uriNetAddressList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Address List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetAddressListConfig(d *schema.ResourceData) *netAddressList {
+	return &netAddressList{
+		Description:  d.Get("description").(string),
+		Addresses:    netListItems(setToStringSlice(d.Get("addresses").(*schema.Set))),
+		Fqdns:        netListItems(setToStringSlice(d.Get("fqdns").(*schema.Set))),
+		AddressLists: netListItems(setToStringSlice(d.Get("address_lists").(*schema.Set))),
+	}
+}
+
+func netListItems(names []string) []netListItem {
+	items := []netListItem{}
+	for _, name := range names {
+		items = append(items, netListItem{Name: name})
+	}
+	return items
+}
+
+func netListItemNames(items []netListItem) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestNetAddressListName = "/Common/test-address-list"
+
+func TestAccBigipNetAddressListCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_net_address_list", uriNetAddressList),
+		Steps: []resource.TestStep{
+			{
+				Config: testNetAddressListResource(`"10.10.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriNetAddressList, TestNetAddressListName),
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-list", "name", TestNetAddressListName),
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-list", "addresses.#", "1"),
+				),
+			},
+			{
+				Config: testNetAddressListResource(`"10.10.0.0/16", "192.0.2.1-192.0.2.9"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_net_address_list.test-list", "addresses.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "bigip_net_address_list.test-list",
+				ImportStateId:     TestNetAddressListName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testNetAddressListResource(addresses string) string {
+	return fmt.Sprintf(`
+resource "bigip_net_address_list" "test-list" {
+  name        = "%s"
+  description = "test address list"
+  addresses   = [%s]
+}
+`, TestNetAddressListName, addresses)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriNetPortList = "net/port-list"
+
+type netPortList struct {
+	Name        string        `json:"name,omitempty"`
+	FullPath    string        `json:"fullPath,omitempty"`
+	Description string        `json:"description"`
+	Ports       []netListItem `json:"ports"`
+	PortLists   []netListItem `json:"portLists"`
+}
+
+func resourceBigipNetPortList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipNetPortListCreate,
+		ReadContext:   resourceBigipNetPortListRead,
+		UpdateContext: resourceBigipNetPortListUpdate,
+		DeleteContext: resourceBigipNetPortListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5NameWithDirectory,
+				Description:  "Name of the Port List",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"ports": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Ports or port ranges, e.g. `443` or `8000-8999`",
+			},
+			"port_lists": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Other port lists included in this one",
+			},
+		},
+	}
+}
+
+func resourceBigipNetPortListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Port List:%+v ", name)
+
+	config := getNetPortListConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriNetPortList)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Port List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipNetPortListRead(ctx, d, meta)
+}
+
+func resourceBigipNetPortListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Port List " + name)
+
+	var list netPortList
+	found, err := getTmEntity(client, &list, uriNetPortList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Port List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Port List (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", list.FullPath)
+	_ = d.Set("description", list.Description)
+	_ = d.Set("ports", netListItemNames(list.Ports))
+	_ = d.Set("port_lists", netListItemNames(list.PortLists))
+	return nil
+}
+
+func resourceBigipNetPortListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Port List:%+v ", name)
+
+	err := patchTmEntity(client, getNetPortListConfig(d), uriNetPortList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Port List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipNetPortListRead(ctx, d, meta)
+}
+
+func resourceBigipNetPortListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Port List " + name)
+
+	err := deleteTmEntity(client, uriNetPortList, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Port List (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getNetPortListConfig(d *schema.ResourceData) *netPortList {
+	return &netPortList{
+		Description: d.Get("description").(string),
+		Ports:       netListItems(setToStringSlice(d.Get("ports").(*schema.Set))),
+		PortLists:   netListItems(setToStringSlice(d.Get("port_lists").(*schema.Set))),
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestNetPortListName = "/Common/test-port-list"
+
+func TestAccBigipNetPortListCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_net_port_list", uriNetPortList),
+		Steps: []resource.TestStep{
+			{
+				Config: testNetPortListResource(`"443"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriNetPortList, TestNetPortListName),
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-list", "name", TestNetPortListName),
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-list", "ports.#", "1"),
+				),
+			},
+			{
+				Config: testNetPortListResource(`"443", "8000-8080"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_net_port_list.test-list", "ports.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "bigip_net_port_list.test-list",
+				ImportStateId:     TestNetPortListName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testNetPortListResource(ports string) string {
+	return fmt.Sprintf(`
+resource "bigip_net_port_list" "test-list" {
+  name        = "%s"
+  description = "test port list"
+  ports       = [%s]
+}
+`, TestNetPortListName, ports)
+}
@@ -0,0 +1,533 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriDosProfile = "security/dos/profile"
+
+// go-bigip's DOSProfile only links to its sub-collections, so the profile and
+// its application and dos-network sub-objects are managed through the tm
+// helpers. Like profiles created in the GUI, the sub-objects are named after
+// the profile.
+type dosProfile struct {
+	Name                 string `json:"name,omitempty"`
+	FullPath             string `json:"fullPath,omitempty"`
+	Description          string `json:"description"`
+	ThresholdSensitivity string `json:"thresholdSensitivity,omitempty"`
+}
+
+type dosApplication struct {
+	Name        string            `json:"name,omitempty"`
+	TpsBased    *dosTpsThresholds `json:"tpsBased,omitempty"`
+	StressBased *dosStressBased   `json:"stressBased,omitempty"`
+}
+
+type dosTpsThresholds struct {
+	Mode                string `json:"mode,omitempty"`
+	IpMinimumTps        int    `json:"ipMinimumTps,omitempty"`
+	IpTpsIncreaseRate   int    `json:"ipTpsIncreaseRate,omitempty"`
+	IpMaximumTps        int    `json:"ipMaximumTps,omitempty"`
+	UrlMinimumTps       int    `json:"urlMinimumTps,omitempty"`
+	UrlTpsIncreaseRate  int    `json:"urlTpsIncreaseRate,omitempty"`
+	UrlMaximumTps       int    `json:"urlMaximumTps,omitempty"`
+	SiteMinimumTps      int    `json:"siteMinimumTps,omitempty"`
+	SiteTpsIncreaseRate int    `json:"siteTpsIncreaseRate,omitempty"`
+	SiteMaximumTps      int    `json:"siteMaximumTps,omitempty"`
+}
+
+type dosStressBased struct {
+	dosTpsThresholds
+	Behavioral *dosBehavioral `json:"behavioral,omitempty"`
+}
+
+type dosBehavioral struct {
+	MitigationMode         string `json:"mitigationMode,omitempty"`
+	DosDetection           string `json:"dosDetection,omitempty"`
+	Signatures             string `json:"signatures,omitempty"`
+	SignaturesApprovedOnly string `json:"signaturesApprovedOnly,omitempty"`
+}
+
+type dosNetwork struct {
+	Name                string             `json:"name,omitempty"`
+	NetworkAttackVector []dosNetworkVector `json:"networkAttackVector"`
+}
+
+type dosNetworkVector struct {
+	Type          string `json:"type"`
+	State         string `json:"state,omitempty"`
+	RateThreshold int    `json:"rateThreshold,omitempty"`
+	RateIncrease  int    `json:"rateIncrease,omitempty"`
+	RateLimit     int    `json:"rateLimit,omitempty"`
+}
+
+var dosProfileChildren = []profileChild{
+	{"application", "application", getDosApplicationConfig},
+	{"network", "dos-network", getDosNetworkConfig},
+}
+
+var dosTpsThresholdFields = map[string]string{
+	"ip_minimum_tps":         "Requests per second from a client address that are always allowed",
+	"ip_tps_increase_rate":   "Percentage increase of requests per second from a client address that is an attack",
+	"ip_maximum_tps":         "Requests per second from a client address that is always an attack",
+	"url_minimum_tps":        "Requests per second to a URL that are always allowed",
+	"url_tps_increase_rate":  "Percentage increase of requests per second to a URL that is an attack",
+	"url_maximum_tps":        "Requests per second to a URL that is always an attack",
+	"site_minimum_tps":       "Requests per second to the site that are always allowed",
+	"site_tps_increase_rate": "Percentage increase of requests per second to the site that is an attack",
+	"site_maximum_tps":       "Requests per second to the site that is always an attack",
+}
+
+func dosTpsThresholdsSchema(description string) *schema.Schema {
+	fields := map[string]*schema.Schema{
+		"mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "off",
+			ValidateFunc: validation.StringInSlice([]string{"off", "transparent", "blocking"}, false),
+			Description:  "`transparent` only reports attacks, `blocking` also mitigates them",
+		},
+	}
+	for field, desc := range dosTpsThresholdFields {
+		fields[field] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  desc,
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem:        &schema.Resource{Schema: fields},
+	}
+}
+
+func resourceBigipSecurityDosProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSecurityDosProfileCreate,
+		ReadContext:   resourceBigipSecurityDosProfileRead,
+		UpdateContext: resourceBigipSecurityDosProfileUpdate,
+		DeleteContext: resourceBigipSecurityDosProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			var vectors []interface{}
+			if network := d.Get("network").([]interface{}); len(network) > 0 && network[0] != nil {
+				vectors = network[0].(map[string]interface{})["vector"].([]interface{})
+			}
+			return validateDosNetworkVectors(vectors)
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the DoS Profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"threshold_sensitivity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high"}, false),
+				Description:  "Sensitivity of automatically learned thresholds",
+			},
+			"application": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Application (L7) DoS protection",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tps_based":    dosTpsThresholdsSchema("Detection of attacks from the requests per second seen by the virtual server"),
+						"stress_based": dosTpsThresholdsSchema("Detection of attacks from the requests per second once the application servers are under stress"),
+						"behavioral": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Behavioral detection and mitigation, part of stress based protection",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mitigation_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "none",
+										ValidateFunc: validation.StringInSlice([]string{"none", "conservative", "standard"}, false),
+										Description:  "How aggressively detected attacks are mitigated",
+									},
+									"dos_detection": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Detect attacks from the behavior of the application servers",
+									},
+									"signatures": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Generate signatures of attacking traffic",
+									},
+									"signatures_approved_only": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Only mitigate with signatures approved by an administrator",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"network": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Network DoS protection",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vector": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "Network attack vectors",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Attack vector, e.g. `syn-flood`, `udp-flood` or `icmpv4-flood`",
+									},
+									"state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "mitigate",
+										ValidateFunc: validation.StringInSlice([]string{"mitigate", "detect-only", "learn-only", "disabled"}, false),
+										Description:  "Whether the vector is mitigated, only detected or only learned",
+									},
+									"rate_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Packets per second that are detected as an attack",
+									},
+									"rate_increase": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Percentage increase of packets per second that is detected as an attack",
+									},
+									"rate_limit": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "Packets per second allowed when mitigating",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityDosProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating DoS Profile:%+v ", name)
+
+	config := &dosProfile{
+		Name:                 name,
+		Description:          d.Get("description").(string),
+		ThresholdSensitivity: d.Get("threshold_sensitivity").(string),
+	}
+	err := createTmEntity(client, config, uriDosProfile)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create DoS Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := syncProfileChildren(client, d, uriDosProfile, name, false, dosProfileChildren); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSecurityDosProfileRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityDosProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching DoS Profile " + name)
+
+	var profile dosProfile
+	found, err := getTmEntity(client, &profile, uriDosProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DoS Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] DoS Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", profile.FullPath)
+	_ = d.Set("description", profile.Description)
+	_ = d.Set("threshold_sensitivity", profile.ThresholdSensitivity)
+
+	child := profileChildName(name)
+	var app dosApplication
+	found, err = getTmEntity(client, &app, uriDosProfile, name, "application", child)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DoS Profile application (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	var application []interface{}
+	if found {
+		application = flattenDosApplication(&app, d.Get("application").([]interface{}))
+	}
+	if err := d.Set("application", application); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving application to state for DoS Profile (%s): %s", name, err))
+	}
+
+	var net dosNetwork
+	found, err = getTmEntity(client, &net, uriDosProfile, name, "dos-network", child)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve DoS Profile dos-network (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	var network []interface{}
+	if found {
+		network = flattenDosNetwork(&net, d.Get("network").([]interface{}))
+	}
+	if err := d.Set("network", network); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving network to state for DoS Profile (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipSecurityDosProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating DoS Profile:%+v ", name)
+
+	config := &dosProfile{
+		Description:          d.Get("description").(string),
+		ThresholdSensitivity: d.Get("threshold_sensitivity").(string),
+	}
+	err := patchTmEntity(client, config, uriDosProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify DoS Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if err := syncProfileChildren(client, d, uriDosProfile, name, true, dosProfileChildren); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSecurityDosProfileRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityDosProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting DoS Profile " + name)
+
+	err := deleteTmEntity(client, uriDosProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete DoS Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getDosApplicationConfig(list []interface{}) interface{} {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	// Detection that is not configured is switched off, BIG-IP keeps the
+	// previous settings otherwise.
+	app := &dosApplication{
+		TpsBased: &dosTpsThresholds{Mode: "off"},
+		StressBased: &dosStressBased{
+			dosTpsThresholds: dosTpsThresholds{Mode: "off"},
+			Behavioral:       &dosBehavioral{MitigationMode: "none", DosDetection: "disabled"},
+		},
+	}
+	if tps := m["tps_based"].([]interface{}); len(tps) > 0 && tps[0] != nil {
+		app.TpsBased = expandDosTpsThresholds(tps[0].(map[string]interface{}))
+	}
+	if stress := m["stress_based"].([]interface{}); len(stress) > 0 && stress[0] != nil {
+		app.StressBased.dosTpsThresholds = *expandDosTpsThresholds(stress[0].(map[string]interface{}))
+	}
+	if behavioral := m["behavioral"].([]interface{}); len(behavioral) > 0 && behavioral[0] != nil {
+		b := behavioral[0].(map[string]interface{})
+		app.StressBased.Behavioral = &dosBehavioral{
+			MitigationMode:         b["mitigation_mode"].(string),
+			DosDetection:           enabledDisabled(b["dos_detection"].(bool)),
+			Signatures:             enabledDisabled(b["signatures"].(bool)),
+			SignaturesApprovedOnly: enabledDisabled(b["signatures_approved_only"].(bool)),
+		}
+	}
+	return app
+}
+
+func getDosNetworkConfig(list []interface{}) interface{} {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	network := &dosNetwork{NetworkAttackVector: []dosNetworkVector{}}
+	for _, item := range list[0].(map[string]interface{})["vector"].([]interface{}) {
+		v := item.(map[string]interface{})
+		network.NetworkAttackVector = append(network.NetworkAttackVector, dosNetworkVector{
+			Type:          v["type"].(string),
+			State:         v["state"].(string),
+			RateThreshold: v["rate_threshold"].(int),
+			RateIncrease:  v["rate_increase"].(int),
+			RateLimit:     v["rate_limit"].(int),
+		})
+	}
+	return network
+}
+
+func expandDosTpsThresholds(m map[string]interface{}) *dosTpsThresholds {
+	return &dosTpsThresholds{
+		Mode:                m["mode"].(string),
+		IpMinimumTps:        m["ip_minimum_tps"].(int),
+		IpTpsIncreaseRate:   m["ip_tps_increase_rate"].(int),
+		IpMaximumTps:        m["ip_maximum_tps"].(int),
+		UrlMinimumTps:       m["url_minimum_tps"].(int),
+		UrlTpsIncreaseRate:  m["url_tps_increase_rate"].(int),
+		UrlMaximumTps:       m["url_maximum_tps"].(int),
+		SiteMinimumTps:      m["site_minimum_tps"].(int),
+		SiteTpsIncreaseRate: m["site_tps_increase_rate"].(int),
+		SiteMaximumTps:      m["site_maximum_tps"].(int),
+	}
+}
+
+func flattenDosTpsThresholds(t *dosTpsThresholds) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"mode":                   t.Mode,
+		"ip_minimum_tps":         t.IpMinimumTps,
+		"ip_tps_increase_rate":   t.IpTpsIncreaseRate,
+		"ip_maximum_tps":         t.IpMaximumTps,
+		"url_minimum_tps":        t.UrlMinimumTps,
+		"url_tps_increase_rate":  t.UrlTpsIncreaseRate,
+		"url_maximum_tps":        t.UrlMaximumTps,
+		"site_minimum_tps":       t.SiteMinimumTps,
+		"site_tps_increase_rate": t.SiteTpsIncreaseRate,
+		"site_maximum_tps":       t.SiteMaximumTps,
+	}}
+}
+
+// flattenDosApplication only returns the blocks that are configured or active
+// on BIG-IP, which always reports every detection method.
+func flattenDosApplication(app *dosApplication, configured []interface{}) []interface{} {
+	cfg := map[string]interface{}{}
+	if len(configured) > 0 && configured[0] != nil {
+		cfg = configured[0].(map[string]interface{})
+	}
+	isConfigured := func(key string) bool {
+		list, ok := cfg[key].([]interface{})
+		return ok && len(list) > 0
+	}
+	m := map[string]interface{}{}
+	if app.TpsBased != nil && (app.TpsBased.Mode != "off" || isConfigured("tps_based")) {
+		m["tps_based"] = flattenDosTpsThresholds(app.TpsBased)
+	}
+	if app.StressBased != nil {
+		if app.StressBased.Mode != "off" || isConfigured("stress_based") {
+			m["stress_based"] = flattenDosTpsThresholds(&app.StressBased.dosTpsThresholds)
+		}
+		if b := app.StressBased.Behavioral; b != nil && (b.MitigationMode != "none" || b.DosDetection == "enabled" || isConfigured("behavioral")) {
+			m["behavioral"] = []interface{}{map[string]interface{}{
+				"mitigation_mode":          b.MitigationMode,
+				"dos_detection":            b.DosDetection == "enabled",
+				"signatures":               b.Signatures == "enabled",
+				"signatures_approved_only": b.SignaturesApprovedOnly == "enabled",
+			}}
+		}
+	}
+	return []interface{}{m}
+}
+
+// flattenDosNetwork only returns the configured vectors in the configured
+// order, BIG-IP reports every vector type in a dos-network sub-profile. All
+// vectors are returned when none is configured, as on import.
+func flattenDosNetwork(net *dosNetwork, configured []interface{}) []interface{} {
+	var types []string
+	if len(configured) > 0 && configured[0] != nil {
+		for _, item := range configured[0].(map[string]interface{})["vector"].([]interface{}) {
+			if item != nil {
+				types = append(types, item.(map[string]interface{})["type"].(string))
+			}
+		}
+	}
+	byType := make(map[string]dosNetworkVector)
+	for _, v := range net.NetworkAttackVector {
+		byType[v.Type] = v
+		if len(configured) == 0 {
+			types = append(types, v.Type)
+		}
+	}
+	var vectors []interface{}
+	for _, vectorType := range types {
+		v, ok := byType[vectorType]
+		if !ok {
+			continue
+		}
+		vectors = append(vectors, map[string]interface{}{
+			"type":           v.Type,
+			"state":          v.State,
+			"rate_threshold": v.RateThreshold,
+			"rate_increase":  v.RateIncrease,
+			"rate_limit":     v.RateLimit,
+		})
+	}
+	return []interface{}{map[string]interface{}{"vector": vectors}}
+}
+
+// validateDosNetworkVectors rejects vectors configured more than once, which
+// BIG-IP would otherwise only report on apply.
+func validateDosNetworkVectors(vectors []interface{}) error {
+	seen := make(map[string]bool)
+	for _, item := range vectors {
+		if item == nil {
+			continue
+		}
+		vectorType := item.(map[string]interface{})["type"].(string)
+		if vectorType == "" {
+			continue
+		}
+		if seen[vectorType] {
+			return fmt.Errorf("network vector %s is configured more than once", vectorType)
+		}
+		seen[vectorType] = true
+	}
+	return nil
+}
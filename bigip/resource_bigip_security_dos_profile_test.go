@@ -0,0 +1,90 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestDosProfileName = "/Common/test-dos-profile"
+
+func TestAccBigipSecurityDosProfileCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_security_dos_profile", uriDosProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testDosProfileResource("transparent", 1000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriDosProfile, TestDosProfileName),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "name", TestDosProfileName),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "application.0.tps_based.0.mode", "transparent"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "application.0.behavioral.0.mitigation_mode", "standard"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "network.0.vector.#", "2"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "network.0.vector.0.rate_limit", "1000"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "profiles.#", "2"),
+				),
+			},
+			{
+				Config: testDosProfileResource("blocking", 2000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "application.0.tps_based.0.mode", "blocking"),
+					resource.TestCheckResourceAttr("bigip_security_dos_profile.test-dos", "network.0.vector.0.rate_limit", "2000"),
+				),
+			},
+			{
+				ResourceName:      "bigip_security_dos_profile.test-dos",
+				ImportStateId:     TestDosProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Import reads every vector type of the dos-network sub-profile.
+				ImportStateVerifyIgnore: []string{"network"},
+			},
+		},
+	})
+}
+
+func testDosProfileResource(mode string, rateLimit int) string {
+	return fmt.Sprintf(`
+resource "bigip_security_dos_profile" "test-dos" {
+  name        = "%s"
+  description = "test dos profile"
+  application {
+    tps_based {
+      mode           = "%s"
+      ip_maximum_tps = 200
+    }
+    behavioral {
+      mitigation_mode = "standard"
+      dos_detection   = true
+    }
+  }
+  network {
+    vector {
+      type       = "syn-flood"
+      rate_limit = %d
+    }
+    vector {
+      type  = "udp-flood"
+      state = "detect-only"
+    }
+  }
+}
+
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name        = "/Common/test-dos-vs"
+  destination = "10.255.255.13"
+  port        = 80
+  profiles    = ["/Common/http", bigip_security_dos_profile.test-dos.name]
+}
+`, TestDosProfileName, mode, rateLimit)
+}
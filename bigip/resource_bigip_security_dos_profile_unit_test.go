@@ -0,0 +1,70 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDosNetworkVectors(t *testing.T) {
+	vectors := []interface{}{
+		map[string]interface{}{"type": "syn-flood"},
+		map[string]interface{}{"type": "udp-flood"},
+	}
+	assert.NoError(t, validateDosNetworkVectors(vectors))
+	assert.NoError(t, validateDosNetworkVectors(nil))
+
+	vectors = append(vectors, map[string]interface{}{"type": "syn-flood"})
+	assert.EqualError(t, validateDosNetworkVectors(vectors), "network vector syn-flood is configured more than once")
+}
+
+func TestFlattenDosApplication(t *testing.T) {
+	app := &dosApplication{
+		TpsBased: &dosTpsThresholds{Mode: "blocking", IpMaximumTps: 200},
+		StressBased: &dosStressBased{
+			dosTpsThresholds: dosTpsThresholds{Mode: "off"},
+			Behavioral:       &dosBehavioral{MitigationMode: "none", DosDetection: "disabled"},
+		},
+	}
+	flat := flattenDosApplication(app, nil)[0].(map[string]interface{})
+	assert.Contains(t, flat, "tps_based")
+	assert.NotContains(t, flat, "stress_based")
+	assert.NotContains(t, flat, "behavioral")
+	assert.Equal(t, 200, flat["tps_based"].([]interface{})[0].(map[string]interface{})["ip_maximum_tps"])
+
+	configured := []interface{}{map[string]interface{}{
+		"stress_based": []interface{}{map[string]interface{}{"mode": "off"}},
+	}}
+	flat = flattenDosApplication(app, configured)[0].(map[string]interface{})
+	assert.Contains(t, flat, "stress_based")
+	assert.NotContains(t, flat, "behavioral")
+
+	app.StressBased.Behavioral.MitigationMode = "standard"
+	flat = flattenDosApplication(app, nil)[0].(map[string]interface{})
+	assert.Equal(t, "standard", flat["behavioral"].([]interface{})[0].(map[string]interface{})["mitigation_mode"])
+}
+
+func TestFlattenDosNetwork(t *testing.T) {
+	net := &dosNetwork{NetworkAttackVector: []dosNetworkVector{
+		{Type: "icmpv4-flood", State: "mitigate", RateLimit: 4294967295},
+		{Type: "syn-flood", State: "mitigate", RateLimit: 1000},
+		{Type: "udp-flood", State: "detect-only", RateLimit: 2000},
+	}}
+	configured := []interface{}{map[string]interface{}{"vector": []interface{}{
+		map[string]interface{}{"type": "udp-flood"},
+		map[string]interface{}{"type": "syn-flood"},
+	}}}
+	vectors := flattenDosNetwork(net, configured)[0].(map[string]interface{})["vector"].([]interface{})
+	assert.Len(t, vectors, 2)
+	assert.Equal(t, "udp-flood", vectors[0].(map[string]interface{})["type"])
+	assert.Equal(t, 2000, vectors[0].(map[string]interface{})["rate_limit"])
+	assert.Equal(t, "syn-flood", vectors[1].(map[string]interface{})["type"])
+
+	vectors = flattenDosNetwork(net, nil)[0].(map[string]interface{})["vector"].([]interface{})
+	assert.Len(t, vectors, 3)
+}
@@ -0,0 +1,449 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriSecurityLogProfile = "security/log/profile"
+
+// go-bigip's SecurityLogProfile models the sub-collections as plain structs, so
+// they cannot be written. The profile and its application, network and
+// dos-application sub-objects are managed through the tm helpers instead.
+type securityLogProfile struct {
+	Name                string `json:"name,omitempty"`
+	FullPath            string `json:"fullPath,omitempty"`
+	Description         string `json:"description"`
+	DosNetworkPublisher string `json:"dosNetworkPublisher,omitempty"`
+}
+
+type securityLogApplication struct {
+	Name             string              `json:"name,omitempty"`
+	Filter           []securityLogFilter `json:"filter,omitempty"`
+	LocalStorage     string              `json:"localStorage,omitempty"`
+	RemoteStorage    string              `json:"remoteStorage,omitempty"`
+	Servers          []securityLogServer `json:"servers"`
+	Protocol         string              `json:"protocol,omitempty"`
+	ResponseLogging  string              `json:"responseLogging,omitempty"`
+	GuaranteeLogging string              `json:"guaranteeLogging,omitempty"`
+}
+
+type securityLogFilter struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values,omitempty"`
+}
+
+type securityLogServer struct {
+	Name string `json:"name"`
+}
+
+type securityLogNetwork struct {
+	Name      string                   `json:"name,omitempty"`
+	Publisher string                   `json:"publisher"`
+	Filter    securityLogNetworkFilter `json:"filter"`
+}
+
+type securityLogNetworkFilter struct {
+	LogAclMatchAccept    string `json:"logAclMatchAccept,omitempty"`
+	LogAclMatchDrop      string `json:"logAclMatchDrop,omitempty"`
+	LogAclMatchReject    string `json:"logAclMatchReject,omitempty"`
+	LogIpErrors          string `json:"logIpErrors,omitempty"`
+	LogTcpErrors         string `json:"logTcpErrors,omitempty"`
+	LogTcpEvents         string `json:"logTcpEvents,omitempty"`
+	LogTranslationFields string `json:"logTranslationFields,omitempty"`
+}
+
+type securityLogDosApplication struct {
+	Name            string `json:"name,omitempty"`
+	RemotePublisher string `json:"remotePublisher"`
+}
+
+var securityLogProfileChildren = []profileChild{
+	{"application", "application", getSecurityLogApplicationConfig},
+	{"network", "network", getSecurityLogNetworkConfig},
+	{"dos", "dos-application", getSecurityLogDosApplicationConfig},
+}
+
+var securityLogNetworkFilterFields = map[string]string{
+	"log_acl_match_accept":   "Log packets accepted by firewall rules",
+	"log_acl_match_drop":     "Log packets dropped by firewall rules",
+	"log_acl_match_reject":   "Log packets rejected by firewall rules",
+	"log_ip_errors":          "Log IP errors",
+	"log_tcp_errors":         "Log TCP errors",
+	"log_tcp_events":         "Log TCP events, such as connections opened and closed",
+	"log_translation_fields": "Log translated (NAT) addresses and ports",
+}
+
+func resourceBigipSecurityLogProfile() *schema.Resource {
+	networkFields := map[string]*schema.Schema{
+		"publisher": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateF5Name,
+			Description:  "Log publisher network firewall events are sent to",
+		},
+	}
+	for field, desc := range securityLogNetworkFilterFields {
+		networkFields[field] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: desc,
+		}
+	}
+	return &schema.Resource{
+		CreateContext: resourceBigipSecurityLogProfileCreate,
+		ReadContext:   resourceBigipSecurityLogProfileRead,
+		UpdateContext: resourceBigipSecurityLogProfileUpdate,
+		DeleteContext: resourceBigipSecurityLogProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			app := d.Get("application").([]interface{})
+			if len(app) == 0 || app[0] == nil {
+				return nil
+			}
+			m := app[0].(map[string]interface{})
+			return validateSecurityLogApplication(m["remote_storage"].(string), m["servers"].(*schema.Set).Len(), m["local_storage"].(bool), m["guarantee_logging"].(bool))
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateF5Name,
+				Description:  "Name of the Security Log Profile",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description",
+			},
+			"application": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Application security (WAF) request logging",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "illegal",
+							ValidateFunc: validation.StringInSlice([]string{"all", "illegal", "illegal-including-staged-signatures"}, false),
+							Description:  "Requests that are logged",
+						},
+						"local_storage": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Store requests on BIG-IP",
+						},
+						"remote_storage": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "none",
+							ValidateFunc: validation.StringInSlice([]string{"none", "remote", "splunk", "arcsight"}, false),
+							Description:  "Format requests are sent to remote servers in",
+						},
+						"servers": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Remote servers as `address:port`, application security logging does not use a log publisher",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"udp", "tcp", "tcp-rfc3195"}, false),
+							Description:  "Protocol used to reach the remote servers",
+						},
+						"response_logging": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "none",
+							ValidateFunc: validation.StringInSlice([]string{"none", "illegal", "all"}, false),
+							Description:  "Responses that are logged along with their requests",
+						},
+						"guarantee_logging": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Hold traffic until requests are stored locally",
+						},
+					},
+				},
+			},
+			"network": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Network firewall (AFM) event logging",
+				Elem:        &schema.Resource{Schema: networkFields},
+			},
+			"dos": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "DoS protection event logging",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_publisher": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "Log publisher application (L7) DoS events are sent to",
+						},
+						"network_publisher": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateF5Name,
+							Description:  "Log publisher network DoS events are sent to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSecurityLogProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating Security Log Profile:%+v ", name)
+
+	config := getSecurityLogProfileConfig(d)
+	config.Name = name
+	err := createTmEntity(client, config, uriSecurityLogProfile)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Security Log Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	if err := syncProfileChildren(client, d, uriSecurityLogProfile, name, false, securityLogProfileChildren); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSecurityLogProfileRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityLogProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching Security Log Profile " + name)
+
+	var profile securityLogProfile
+	found, err := getTmEntity(client, &profile, uriSecurityLogProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Security Log Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Security Log Profile (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", profile.FullPath)
+	_ = d.Set("description", profile.Description)
+	child := profileChildName(name)
+
+	var app securityLogApplication
+	found, err = getTmEntity(client, &app, uriSecurityLogProfile, name, "application", child)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Security Log Profile application (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	var application []interface{}
+	if found {
+		requestType := ""
+		for _, f := range app.Filter {
+			if f.Key == "request-type" && len(f.Values) > 0 {
+				requestType = f.Values[0]
+			}
+		}
+		var servers []string
+		for _, s := range app.Servers {
+			servers = append(servers, s.Name)
+		}
+		application = []interface{}{map[string]interface{}{
+			"request_type":      requestType,
+			"local_storage":     app.LocalStorage == "enabled",
+			"remote_storage":    app.RemoteStorage,
+			"servers":           servers,
+			"protocol":          app.Protocol,
+			"response_logging":  app.ResponseLogging,
+			"guarantee_logging": app.GuaranteeLogging == "enabled",
+		}}
+	}
+	if err := d.Set("application", application); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving application to state for Security Log Profile (%s): %s", name, err))
+	}
+
+	var net securityLogNetwork
+	found, err = getTmEntity(client, &net, uriSecurityLogProfile, name, "network", child)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Security Log Profile network (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	var network []interface{}
+	if found {
+		network = []interface{}{map[string]interface{}{
+			"publisher":              net.Publisher,
+			"log_acl_match_accept":   net.Filter.LogAclMatchAccept == "enabled",
+			"log_acl_match_drop":     net.Filter.LogAclMatchDrop == "enabled",
+			"log_acl_match_reject":   net.Filter.LogAclMatchReject == "enabled",
+			"log_ip_errors":          net.Filter.LogIpErrors == "enabled",
+			"log_tcp_errors":         net.Filter.LogTcpErrors == "enabled",
+			"log_tcp_events":         net.Filter.LogTcpEvents == "enabled",
+			"log_translation_fields": net.Filter.LogTranslationFields == "enabled",
+		}}
+	}
+	if err := d.Set("network", network); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving network to state for Security Log Profile (%s): %s", name, err))
+	}
+
+	var dosApp securityLogDosApplication
+	found, err = getTmEntity(client, &dosApp, uriSecurityLogProfile, name, "dos-application", child)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Security Log Profile dos-application (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	dos := map[string]interface{}{}
+	if found && dosApp.RemotePublisher != "" && dosApp.RemotePublisher != "none" {
+		dos["application_publisher"] = dosApp.RemotePublisher
+	}
+	if profile.DosNetworkPublisher != "" && profile.DosNetworkPublisher != "none" {
+		dos["network_publisher"] = profile.DosNetworkPublisher
+	}
+	var dosList []interface{}
+	if len(dos) > 0 {
+		dosList = []interface{}{dos}
+	}
+	if err := d.Set("dos", dosList); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving dos to state for Security Log Profile (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipSecurityLogProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating Security Log Profile:%+v ", name)
+
+	err := patchTmEntity(client, getSecurityLogProfileConfig(d), uriSecurityLogProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify Security Log Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if err := syncProfileChildren(client, d, uriSecurityLogProfile, name, true, securityLogProfileChildren); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSecurityLogProfileRead(ctx, d, meta)
+}
+
+func resourceBigipSecurityLogProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting Security Log Profile " + name)
+
+	err := deleteTmEntity(client, uriSecurityLogProfile, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete Security Log Profile (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSecurityLogProfileConfig(d *schema.ResourceData) *securityLogProfile {
+	config := &securityLogProfile{
+		Description:         d.Get("description").(string),
+		DosNetworkPublisher: "none",
+	}
+	if dos := d.Get("dos").([]interface{}); len(dos) > 0 && dos[0] != nil {
+		if publisher := dos[0].(map[string]interface{})["network_publisher"].(string); publisher != "" {
+			config.DosNetworkPublisher = publisher
+		}
+	}
+	return config
+}
+
+func getSecurityLogApplicationConfig(list []interface{}) interface{} {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	app := &securityLogApplication{
+		Filter:           []securityLogFilter{{Key: "request-type", Values: []string{m["request_type"].(string)}}},
+		LocalStorage:     enabledDisabled(m["local_storage"].(bool)),
+		RemoteStorage:    m["remote_storage"].(string),
+		Servers:          []securityLogServer{},
+		Protocol:         m["protocol"].(string),
+		ResponseLogging:  m["response_logging"].(string),
+		GuaranteeLogging: enabledDisabled(m["guarantee_logging"].(bool)),
+	}
+	for _, server := range setToStringSlice(m["servers"].(*schema.Set)) {
+		app.Servers = append(app.Servers, securityLogServer{Name: server})
+	}
+	return app
+}
+
+func getSecurityLogNetworkConfig(list []interface{}) interface{} {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	return &securityLogNetwork{
+		Publisher: m["publisher"].(string),
+		Filter: securityLogNetworkFilter{
+			LogAclMatchAccept:    enabledDisabled(m["log_acl_match_accept"].(bool)),
+			LogAclMatchDrop:      enabledDisabled(m["log_acl_match_drop"].(bool)),
+			LogAclMatchReject:    enabledDisabled(m["log_acl_match_reject"].(bool)),
+			LogIpErrors:          enabledDisabled(m["log_ip_errors"].(bool)),
+			LogTcpErrors:         enabledDisabled(m["log_tcp_errors"].(bool)),
+			LogTcpEvents:         enabledDisabled(m["log_tcp_events"].(bool)),
+			LogTranslationFields: enabledDisabled(m["log_translation_fields"].(bool)),
+		},
+	}
+}
+
+func getSecurityLogDosApplicationConfig(list []interface{}) interface{} {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	publisher := list[0].(map[string]interface{})["application_publisher"].(string)
+	if publisher == "" {
+		return nil
+	}
+	return &securityLogDosApplication{RemotePublisher: publisher}
+}
+
+// validateSecurityLogApplication checks the storage settings of application
+// logging, which BIG-IP would otherwise only reject on apply.
+func validateSecurityLogApplication(remoteStorage string, servers int, localStorage, guaranteeLogging bool) error {
+	if remoteStorage == "none" && servers > 0 {
+		return fmt.Errorf("application servers require remote_storage to be set")
+	}
+	if remoteStorage != "none" && servers == 0 {
+		return fmt.Errorf("application remote_storage %s requires at least one server", remoteStorage)
+	}
+	if !localStorage && remoteStorage == "none" {
+		return fmt.Errorf("application logging needs local_storage or remote_storage")
+	}
+	if guaranteeLogging && !localStorage {
+		return fmt.Errorf("application guarantee_logging requires local_storage")
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+var TestSecurityLogProfileName = "/Common/test-security-log"
+
+func TestAccBigipSecurityLogProfileCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckTmEntitiesDestroyed("bigip_security_log_profile", uriSecurityLogProfile),
+		Steps: []resource.TestStep{
+			{
+				Config: testSecurityLogProfileResource("illegal", "none", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckTmEntityExists(uriSecurityLogProfile, TestSecurityLogProfileName),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "name", TestSecurityLogProfileName),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "application.0.request_type", "illegal"),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "network.0.log_acl_match_drop", "true"),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "dos.0.network_publisher", "/Common/local-db-publisher"),
+					resource.TestCheckResourceAttr("bigip_ltm_virtual_server.test-vs", "security_log_profiles.#", "1"),
+				),
+			},
+			{
+				Config: testSecurityLogProfileResource("all", "remote", `"192.0.2.50:514"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "application.0.request_type", "all"),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "application.0.remote_storage", "remote"),
+					resource.TestCheckResourceAttr("bigip_security_log_profile.test-log", "application.0.servers.#", "1"),
+				),
+			},
+			{
+				Config:      testSecurityLogProfileResource("all", "splunk", ""),
+				ExpectError: regexp.MustCompile("application remote_storage splunk requires at least one server"),
+			},
+			{
+				ResourceName:      "bigip_security_log_profile.test-log",
+				ImportStateId:     TestSecurityLogProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testSecurityLogProfileResource(requestType, remoteStorage, servers string) string {
+	return fmt.Sprintf(`
+resource "bigip_security_log_profile" "test-log" {
+  name        = "%s"
+  description = "test security log profile"
+  application {
+    request_type   = "%s"
+    remote_storage = "%s"
+    servers        = [%s]
+  }
+  network {
+    publisher          = "/Common/local-db-publisher"
+    log_acl_match_drop = true
+  }
+  dos {
+    network_publisher = "/Common/local-db-publisher"
+  }
+}
+
+resource "bigip_ltm_virtual_server" "test-vs" {
+  name                  = "/Common/test-security-log-vs"
+  destination           = "10.255.255.14"
+  port                  = 80
+  security_log_profiles = [bigip_security_log_profile.test-log.name]
+}
+`, TestSecurityLogProfileName, requestType, remoteStorage, servers)
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSecurityLogApplication(t *testing.T) {
+	assert.NoError(t, validateSecurityLogApplication("none", 0, true, false))
+	assert.NoError(t, validateSecurityLogApplication("splunk", 2, false, false))
+	assert.NoError(t, validateSecurityLogApplication("remote", 1, true, true))
+
+	assert.EqualError(t, validateSecurityLogApplication("none", 1, true, false), "application servers require remote_storage to be set")
+	assert.EqualError(t, validateSecurityLogApplication("arcsight", 0, true, false), "application remote_storage arcsight requires at least one server")
+	assert.EqualError(t, validateSecurityLogApplication("none", 0, false, false), "application logging needs local_storage or remote_storage")
+	assert.EqualError(t, validateSecurityLogApplication("remote", 1, false, true), "application guarantee_logging requires local_storage")
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAuthLdap = "auth/ldap"
+
+type sysAuthLdap struct {
+	Name            string   `json:"name,omitempty"`
+	Servers         []string `json:"servers,omitempty"`
+	Port            int      `json:"port,omitempty"`
+	BindDn          string   `json:"bindDn,omitempty"`
+	BindPw          string   `json:"bindPw,omitempty"`
+	SearchBaseDn    string   `json:"searchBaseDn,omitempty"`
+	Scope           string   `json:"scope,omitempty"`
+	LoginAttribute  string   `json:"loginAttribute,omitempty"`
+	UserTemplate    string   `json:"userTemplate,omitempty"`
+	CheckRolesGroup string   `json:"checkRolesGroup,omitempty"`
+	Ssl             string   `json:"ssl,omitempty"`
+	SslCaCertFile   string   `json:"sslCaCertFile,omitempty"`
+	SslCheckPeer    string   `json:"sslCheckPeer,omitempty"`
+	SearchTimeout   int      `json:"searchTimeout,omitempty"`
+	Version         int      `json:"version,omitempty"`
+}
+
+func resourceBigipSysAuthLdap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysAuthLdapCreate,
+		ReadContext:   resourceBigipSysAuthLdapRead,
+		UpdateContext: resourceBigipSysAuthLdapUpdate,
+		DeleteContext: resourceBigipSysAuthLdapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "system-auth",
+				Description: "Name of the LDAP configuration. BIG-IP only uses `system-auth` for administrative login",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "LDAP servers, as host names or IP addresses",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Port of the LDAP servers, 389 unless `ssl` is `enabled`",
+			},
+			"bind_dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name used to bind to the directory when searching for users",
+			},
+			"bind_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password for `bind_dn`. BIG-IP only returns it encrypted, so it is not read back",
+			},
+			"search_base_dn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Search base for user lookups, for example `ou=people,dc=example,dc=com`",
+			},
+			"search_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Depth of the user search below the search base",
+				ValidateFunc: validation.StringInSlice([]string{"base", "one", "sub"}, false),
+			},
+			"login_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute holding the login name, such as `uid` or `samaccountname`",
+			},
+			"user_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template used to build a user DN directly instead of searching, for example `uid=%s,ou=people,dc=example,dc=com`",
+			},
+			"check_roles_group": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Enables checking group membership of the user for remote role mapping",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+			"ssl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Use LDAPS (`enabled`), StartTLS (`start-tls`) or a plain connection (`disabled`)",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled", "start-tls"}, false),
+			},
+			"ssl_ca_cert_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "CA certificate used to validate the LDAP servers",
+				ValidateFunc: validateF5Name,
+			},
+			"ssl_check_peer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Require the LDAP server certificate to validate against `ssl_ca_cert_file`",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+			"search_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Seconds to wait for a search to complete",
+			},
+			"ldap_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "LDAP protocol version",
+				ValidateFunc: validation.IntInSlice([]int{2, 3}),
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthLdapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating LDAP Auth Config:%+v ", name)
+
+	config := getSysAuthLdapConfig(d)
+	config.Name = name
+	config.BindPw = d.Get("bind_password").(string)
+
+	err := createTmEntity(client, config, uriAuthLdap)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create LDAP Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipSysAuthLdapRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthLdapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching LDAP Auth Config " + name)
+
+	var ldap sysAuthLdap
+	found, err := getTmEntity(client, &ldap, uriAuthLdap, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve LDAP Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] LDAP Auth Config (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("servers", ldap.Servers)
+	_ = d.Set("port", ldap.Port)
+	_ = d.Set("bind_dn", ldap.BindDn)
+	_ = d.Set("search_base_dn", ldap.SearchBaseDn)
+	_ = d.Set("search_scope", ldap.Scope)
+	_ = d.Set("login_attribute", ldap.LoginAttribute)
+	_ = d.Set("user_template", ldap.UserTemplate)
+	_ = d.Set("check_roles_group", ldap.CheckRolesGroup)
+	_ = d.Set("ssl", ldap.Ssl)
+	_ = d.Set("ssl_check_peer", ldap.SslCheckPeer)
+	_ = d.Set("search_timeout", ldap.SearchTimeout)
+	_ = d.Set("ldap_version", ldap.Version)
+	if _, ok := d.GetOk("ssl_ca_cert_file"); ok {
+		_ = d.Set("ssl_ca_cert_file", ldap.SslCaCertFile)
+	}
+	return nil
+}
+
+func resourceBigipSysAuthLdapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating LDAP Auth Config:%+v ", name)
+
+	config := getSysAuthLdapConfig(d)
+	if d.HasChange("bind_password") {
+		config.BindPw = d.Get("bind_password").(string)
+	}
+	err := patchTmEntity(client, config, uriAuthLdap, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify LDAP Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysAuthLdapRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthLdapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting LDAP Auth Config " + name)
+
+	err := deleteTmEntity(client, uriAuthLdap, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete LDAP Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthLdapConfig(d *schema.ResourceData) *sysAuthLdap {
+	return &sysAuthLdap{
+		Servers:         listToStringSlice(d.Get("servers").([]interface{})),
+		Port:            d.Get("port").(int),
+		BindDn:          d.Get("bind_dn").(string),
+		SearchBaseDn:    d.Get("search_base_dn").(string),
+		Scope:           d.Get("search_scope").(string),
+		LoginAttribute:  d.Get("login_attribute").(string),
+		UserTemplate:    d.Get("user_template").(string),
+		CheckRolesGroup: d.Get("check_roles_group").(string),
+		Ssl:             d.Get("ssl").(string),
+		SslCaCertFile:   d.Get("ssl_ca_cert_file").(string),
+		SslCheckPeer:    d.Get("ssl_check_peer").(string),
+		SearchTimeout:   d.Get("search_timeout").(int),
+		Version:         d.Get("ldap_version").(int),
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysAuthLdapResource = `
+resource "bigip_sys_auth_ldap" "test_ldap" {
+  servers           = ["10.10.10.21", "10.10.10.22"]
+  port              = 636
+  bind_dn           = "cn=bigip,ou=services,dc=example,dc=com"
+  bind_password     = "bind-secret"
+  search_base_dn    = "ou=people,dc=example,dc=com"
+  search_scope      = "sub"
+  login_attribute   = "uid"
+  ssl               = "enabled"
+  check_roles_group = "enabled"
+}
+`
+
+func TestAccBigipSysAuthLdapCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthLdapDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSysAuthLdapResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthLdapExists("system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_ldap.test_ldap", "name", "system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_ldap.test_ldap", "servers.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_ldap.test_ldap", "port", "636"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_ldap.test_ldap", "search_base_dn", "ou=people,dc=example,dc=com"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_ldap.test_ldap", "ssl", "enabled"),
+				),
+			},
+			{
+				ResourceName:            "bigip_sys_auth_ldap.test_ldap",
+				ImportStateId:           "system-auth",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password"},
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthLdapExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var ldap sysAuthLdap
+		found, err := getTmEntity(client, &ldap, uriAuthLdap, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("LDAP Auth Config %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysAuthLdapDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_auth_ldap" {
+			continue
+		}
+		var ldap sysAuthLdap
+		found, err := getTmEntity(client, &ldap, uriAuthLdap, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("LDAP Auth Config %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
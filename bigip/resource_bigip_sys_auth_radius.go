@@ -0,0 +1,161 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAuthRadius = "auth/radius"
+
+type sysAuthRadius struct {
+	Name        string   `json:"name,omitempty"`
+	Servers     []string `json:"servers,omitempty"`
+	Accounting  string   `json:"accounting,omitempty"`
+	ClientId    string   `json:"clientId,omitempty"`
+	Retries     int      `json:"retries,omitempty"`
+	ServiceType string   `json:"serviceType,omitempty"`
+}
+
+func resourceBigipSysAuthRadius() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysAuthRadiusCreate,
+		ReadContext:   resourceBigipSysAuthRadiusRead,
+		UpdateContext: resourceBigipSysAuthRadiusUpdate,
+		DeleteContext: resourceBigipSysAuthRadiusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "system-auth",
+				Description: "Name of the RADIUS configuration. BIG-IP only uses `system-auth` for administrative login",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    2,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Primary and optional secondary RADIUS server, as `bigip_sys_auth_radius_server` names",
+			},
+			"accounting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether accounting records go to the first server only or to all servers",
+				ValidateFunc: validation.StringInSlice([]string{"send-to-first-server", "send-to-all-servers"}, false),
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "NAS-Identifier sent to the RADIUS servers",
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of retries before a server is considered unreachable",
+			},
+			"service_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Service-Type attribute sent in access requests, for example `authenticate-only` or `login`",
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthRadiusCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating RADIUS Auth Config:%+v ", name)
+
+	config := getSysAuthRadiusConfig(d)
+	config.Name = name
+
+	err := createTmEntity(client, config, uriAuthRadius)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create RADIUS Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipSysAuthRadiusRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthRadiusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching RADIUS Auth Config " + name)
+
+	var radius sysAuthRadius
+	found, err := getTmEntity(client, &radius, uriAuthRadius, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve RADIUS Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] RADIUS Auth Config (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("servers", radius.Servers)
+	_ = d.Set("accounting", radius.Accounting)
+	_ = d.Set("client_id", radius.ClientId)
+	_ = d.Set("retries", radius.Retries)
+	_ = d.Set("service_type", radius.ServiceType)
+	return nil
+}
+
+func resourceBigipSysAuthRadiusUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating RADIUS Auth Config:%+v ", name)
+
+	config := getSysAuthRadiusConfig(d)
+	err := patchTmEntity(client, config, uriAuthRadius, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify RADIUS Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysAuthRadiusRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthRadiusDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting RADIUS Auth Config " + name)
+
+	err := deleteTmEntity(client, uriAuthRadius, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthRadiusConfig(d *schema.ResourceData) *sysAuthRadius {
+	return &sysAuthRadius{
+		Servers:     listToStringSlice(d.Get("servers").([]interface{})),
+		Accounting:  d.Get("accounting").(string),
+		ClientId:    d.Get("client_id").(string),
+		Retries:     d.Get("retries").(int),
+		ServiceType: d.Get("service_type").(string),
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const uriAuthRadiusServer = "auth/radius-server"
+
+type sysAuthRadiusServer struct {
+	Name    string `json:"name,omitempty"`
+	Server  string `json:"server,omitempty"`
+	Port    int    `json:"port,omitempty"`
+	Secret  string `json:"secret,omitempty"`
+	Timeout int    `json:"timeout,omitempty"`
+}
+
+func resourceBigipSysAuthRadiusServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysAuthRadiusServerCreate,
+		ReadContext:   resourceBigipSysAuthRadiusServerRead,
+		UpdateContext: resourceBigipSysAuthRadiusServerUpdate,
+		DeleteContext: resourceBigipSysAuthRadiusServerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the RADIUS server, for system authentication `/Common/system_auth_name1` or `/Common/system_auth_name2`",
+				ValidateFunc: validateF5Name,
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address of the RADIUS server",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1812,
+				Description: "Authentication port of the RADIUS server",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Shared secret. BIG-IP only returns it encrypted, so it is not read back",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3,
+				Description: "Seconds to wait for a response from the server",
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthRadiusServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating RADIUS Server:%+v ", name)
+
+	config := getSysAuthRadiusServerConfig(d)
+	config.Name = name
+	config.Secret = d.Get("secret").(string)
+
+	err := createTmEntity(client, config, uriAuthRadiusServer)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create RADIUS Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipSysAuthRadiusServerRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthRadiusServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching RADIUS Server " + name)
+
+	var server sysAuthRadiusServer
+	found, err := getTmEntity(client, &server, uriAuthRadiusServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve RADIUS Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] RADIUS Server (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("server", server.Server)
+	_ = d.Set("port", server.Port)
+	_ = d.Set("timeout", server.Timeout)
+	return nil
+}
+
+func resourceBigipSysAuthRadiusServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating RADIUS Server:%+v ", name)
+
+	config := getSysAuthRadiusServerConfig(d)
+	if d.HasChange("secret") {
+		config.Secret = d.Get("secret").(string)
+	}
+	err := patchTmEntity(client, config, uriAuthRadiusServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify RADIUS Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysAuthRadiusServerRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthRadiusServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting RADIUS Server " + name)
+
+	err := deleteTmEntity(client, uriAuthRadiusServer, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete RADIUS Server (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthRadiusServerConfig(d *schema.ResourceData) *sysAuthRadiusServer {
+	return &sysAuthRadiusServer{
+		Server:  d.Get("server").(string),
+		Port:    d.Get("port").(int),
+		Timeout: d.Get("timeout").(int),
+	}
+}
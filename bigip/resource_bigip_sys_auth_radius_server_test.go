@@ -0,0 +1,82 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysAuthRadiusServerName = "/Common/system_auth_name1"
+
+var TestSysAuthRadiusServerResource = `
+resource "bigip_sys_auth_radius_server" "test_radius_server" {
+  name    = "` + TestSysAuthRadiusServerName + `"
+  server  = "10.10.10.31"
+  secret  = "radius-secret"
+  port    = 1812
+  timeout = 5
+}
+`
+
+func TestAccBigipSysAuthRadiusServerCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthRadiusServerDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSysAuthRadiusServerResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthRadiusServerExists(TestSysAuthRadiusServerName),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test_radius_server", "name", TestSysAuthRadiusServerName),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test_radius_server", "server", "10.10.10.31"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test_radius_server", "port", "1812"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius_server.test_radius_server", "timeout", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthRadiusServerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var server sysAuthRadiusServer
+		found, err := getTmEntity(client, &server, uriAuthRadiusServer, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("RADIUS Server %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysAuthRadiusServerDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_auth_radius_server" {
+			continue
+		}
+		var server sysAuthRadiusServer
+		found, err := getTmEntity(client, &server, uriAuthRadiusServer, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("RADIUS Server %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysAuthRadiusResource = `
+resource "bigip_sys_auth_radius_server" "primary" {
+  name   = "/Common/system_auth_name1"
+  server = "10.10.10.31"
+  secret = "radius-secret"
+}
+resource "bigip_sys_auth_radius_server" "secondary" {
+  name   = "/Common/system_auth_name2"
+  server = "10.10.10.32"
+  secret = "radius-secret"
+}
+resource "bigip_sys_auth_radius" "test_radius" {
+  servers    = [bigip_sys_auth_radius_server.primary.name, bigip_sys_auth_radius_server.secondary.name]
+  accounting = "send-to-first-server"
+  retries    = 3
+}
+`
+
+func TestAccBigipSysAuthRadiusCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthRadiusDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSysAuthRadiusResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthRadiusExists("system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius.test_radius", "name", "system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius.test_radius", "servers.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius.test_radius", "accounting", "send-to-first-server"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_radius.test_radius", "retries", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthRadiusExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var radius sysAuthRadius
+		found, err := getTmEntity(client, &radius, uriAuthRadius, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("RADIUS Auth Config %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysAuthRadiusDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_auth_radius" {
+			continue
+		}
+		var radius sysAuthRadius
+		found, err := getTmEntity(client, &radius, uriAuthRadius, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("RADIUS Auth Config %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAuthSource = "auth/source"
+
+type sysAuthSource struct {
+	Type     string `json:"type,omitempty"`
+	Fallback string `json:"fallback,omitempty"`
+}
+
+// this module does not have a DELETE API, destroy switches back to local authentication
+func resourceBigipSysAuthSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysAuthSourceCreate,
+		ReadContext:   resourceBigipSysAuthSourceRead,
+		UpdateContext: resourceBigipSysAuthSourceUpdate,
+		DeleteContext: resourceBigipSysAuthSourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Authentication source used for administrative login",
+				ValidateFunc: validation.StringInSlice([]string{"local", "ldap", "active-directory", "radius", "tacacs", "clientcert-ldap"}, false),
+			},
+			"fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Fall back to local accounts when the remote servers are unreachable",
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Printf("[INFO] Configuring Auth Source:%+v ", d.Get("type").(string))
+
+	if err := setSysAuthSource(client, getSysAuthSourceConfig(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("auth-source")
+	return resourceBigipSysAuthSourceRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Fetching Auth Source")
+
+	var source sysAuthSource
+	_, err := getTmEntity(client, &source, uriAuthSource)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Auth Source (%v) ", err)
+		return diag.FromErr(err)
+	}
+	_ = d.Set("type", source.Type)
+	_ = d.Set("fallback", source.Fallback == "true")
+	return nil
+}
+
+func resourceBigipSysAuthSourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Printf("[INFO] Updating Auth Source:%+v ", d.Get("type").(string))
+
+	if err := setSysAuthSource(client, getSysAuthSourceConfig(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysAuthSourceRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Resetting Auth Source to local")
+
+	if err := setSysAuthSource(client, &sysAuthSource{Type: "local", Fallback: "false"}); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthSourceConfig(d *schema.ResourceData) *sysAuthSource {
+	fallback := "false"
+	if d.Get("fallback").(bool) {
+		fallback = "true"
+	}
+	return &sysAuthSource{
+		Type:     d.Get("type").(string),
+		Fallback: fallback,
+	}
+}
+
+// setSysAuthSource switches the system auth source and then logs in again with
+// the provider credentials. If that login fails the previous source is
+// restored, so a bad LDAP/RADIUS/TACACS+ setup cannot lock the provider out.
+func setSysAuthSource(client *bigip.BigIP, config *sysAuthSource) error {
+	var previous sysAuthSource
+	if _, err := getTmEntity(client, &previous, uriAuthSource); err != nil {
+		return err
+	}
+	if err := patchTmEntity(client, config, uriAuthSource); err != nil {
+		log.Printf("[ERROR] Unable to Modify Auth Source (%s) (%v) ", config.Type, err)
+		return err
+	}
+	if client.User == "" || client.Password == "" {
+		log.Printf("[WARN] Provider has no username/password configured, skipping login check after switching auth source to %s", config.Type)
+		return nil
+	}
+	loginErr := verifySysAuthLogin(client)
+	if loginErr == nil {
+		return nil
+	}
+	log.Printf("[ERROR] Login as %s failed after switching auth source to %s, restoring %s", client.User, config.Type, previous.Type)
+	if err := patchTmEntity(client, &previous, uriAuthSource); err != nil {
+		return fmt.Errorf("login as %s failed after switching auth source to %s (%v), and restoring auth source %s failed: %v", client.User, config.Type, loginErr, previous.Type, err)
+	}
+	return fmt.Errorf("login as %s failed after switching auth source to %s, auth source was restored to %s: %v", client.User, config.Type, previous.Type, loginErr)
+}
+
+func verifySysAuthLogin(client *bigip.BigIP) error {
+	body := map[string]string{
+		"username":          client.User,
+		"password":          client.Password,
+		"loginProviderName": "tmos",
+	}
+	_, err := tmRequest(client, "post", body, "mgmt/shared/authn/login")
+	return err
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Switching to a remote source in CI would depend on a reachable directory,
+// so the test only toggles fallback on the local source.
+var TestSysAuthSourceResource = `
+resource "bigip_sys_auth_source" "test_source" {
+  type     = "local"
+  fallback = %t
+}
+`
+
+func TestAccBigipSysAuthSourceCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthSourceLocal,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(TestSysAuthSourceResource, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_auth_source.test_source", "type", "local"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_source.test_source", "fallback", "false"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(TestSysAuthSourceResource, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_auth_source.test_source", "fallback", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthSourceLocal(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	var source sysAuthSource
+	if _, err := getTmEntity(client, &source, uriAuthSource); err != nil {
+		return err
+	}
+	if source.Type != "local" {
+		return fmt.Errorf("Auth Source is %s, expected local after destroy ", source.Type)
+	}
+	return nil
+}
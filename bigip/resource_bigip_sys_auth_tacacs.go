@@ -0,0 +1,181 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAuthTacacs = "auth/tacacs"
+
+type sysAuthTacacs struct {
+	Name           string   `json:"name,omitempty"`
+	Servers        []string `json:"servers,omitempty"`
+	Secret         string   `json:"secret,omitempty"`
+	Service        string   `json:"service,omitempty"`
+	Protocol       string   `json:"protocol,omitempty"`
+	Authentication string   `json:"authentication,omitempty"`
+	Accounting     string   `json:"accounting,omitempty"`
+	Encryption     string   `json:"encryption,omitempty"`
+}
+
+func resourceBigipSysAuthTacacs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysAuthTacacsCreate,
+		ReadContext:   resourceBigipSysAuthTacacsRead,
+		UpdateContext: resourceBigipSysAuthTacacsUpdate,
+		DeleteContext: resourceBigipSysAuthTacacsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "system-auth",
+				Description: "Name of the TACACS+ configuration. BIG-IP only uses `system-auth` for administrative login",
+			},
+			"servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "TACACS+ servers, as host names or IP addresses",
+			},
+			"secret": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Shared secret. BIG-IP only returns it encrypted, so it is not read back",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Service name sent in authorization requests, for example `ppp`",
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Protocol associated with `service`, for example `ip` or `lcp`",
+			},
+			"authentication": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether to authenticate against the first reachable server or try all servers",
+				ValidateFunc: validation.StringInSlice([]string{"use-first-server", "use-all-servers"}, false),
+			},
+			"accounting": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether accounting records go to the first server only or to all servers",
+				ValidateFunc: validation.StringInSlice([]string{"send-to-first-server", "send-to-all-servers"}, false),
+			},
+			"encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Encrypt TACACS+ packets with the shared secret",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+			},
+		},
+	}
+}
+
+func resourceBigipSysAuthTacacsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating TACACS+ Auth Config:%+v ", name)
+
+	config := getSysAuthTacacsConfig(d)
+	config.Name = name
+	config.Secret = d.Get("secret").(string)
+
+	err := createTmEntity(client, config, uriAuthTacacs)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create TACACS+ Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipSysAuthTacacsRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthTacacsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching TACACS+ Auth Config " + name)
+
+	var tacacs sysAuthTacacs
+	found, err := getTmEntity(client, &tacacs, uriAuthTacacs, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve TACACS+ Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] TACACS+ Auth Config (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("servers", tacacs.Servers)
+	_ = d.Set("service", tacacs.Service)
+	_ = d.Set("protocol", tacacs.Protocol)
+	_ = d.Set("authentication", tacacs.Authentication)
+	_ = d.Set("accounting", tacacs.Accounting)
+	_ = d.Set("encryption", tacacs.Encryption)
+	return nil
+}
+
+func resourceBigipSysAuthTacacsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating TACACS+ Auth Config:%+v ", name)
+
+	config := getSysAuthTacacsConfig(d)
+	if d.HasChange("secret") {
+		config.Secret = d.Get("secret").(string)
+	}
+	err := patchTmEntity(client, config, uriAuthTacacs, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify TACACS+ Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysAuthTacacsRead(ctx, d, meta)
+}
+
+func resourceBigipSysAuthTacacsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting TACACS+ Auth Config " + name)
+
+	err := deleteTmEntity(client, uriAuthTacacs, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete TACACS+ Auth Config (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysAuthTacacsConfig(d *schema.ResourceData) *sysAuthTacacs {
+	return &sysAuthTacacs{
+		Servers:        listToStringSlice(d.Get("servers").([]interface{})),
+		Service:        d.Get("service").(string),
+		Protocol:       d.Get("protocol").(string),
+		Authentication: d.Get("authentication").(string),
+		Accounting:     d.Get("accounting").(string),
+		Encryption:     d.Get("encryption").(string),
+	}
+}
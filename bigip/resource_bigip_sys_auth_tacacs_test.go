@@ -0,0 +1,81 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysAuthTacacsResource = `
+resource "bigip_sys_auth_tacacs" "test_tacacs" {
+  servers        = ["10.10.10.41"]
+  secret         = "tacacs-secret"
+  service        = "ppp"
+  protocol       = "ip"
+  authentication = "use-all-servers"
+  encryption     = "enabled"
+}
+`
+
+func TestAccBigipSysAuthTacacsCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysAuthTacacsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: TestSysAuthTacacsResource,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysAuthTacacsExists("system-auth"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test_tacacs", "servers.0", "10.10.10.41"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test_tacacs", "service", "ppp"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test_tacacs", "protocol", "ip"),
+					resource.TestCheckResourceAttr("bigip_sys_auth_tacacs.test_tacacs", "authentication", "use-all-servers"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipSysAuthTacacsExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var tacacs sysAuthTacacs
+		found, err := getTmEntity(client, &tacacs, uriAuthTacacs, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("TACACS+ Auth Config %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysAuthTacacsDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_auth_tacacs" {
+			continue
+		}
+		var tacacs sysAuthTacacs
+		found, err := getTmEntity(client, &tacacs, uriAuthTacacs, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("TACACS+ Auth Config %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
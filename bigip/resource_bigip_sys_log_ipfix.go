@@ -0,0 +1,164 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// go-bigip's LogIPFIX helpers use "logConfig" in the URL, which BIG-IP rejects,
+// so the resource reuses the LogIPFIX type but talks to log-config directly.
+const uriLogIPFIX = "sys/log-config/destination/ipfix"
+
+func resourceBigipSysLogIPFIX() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysLogIPFIXCreate,
+		ReadContext:   resourceBigipSysLogIPFIXRead,
+		UpdateContext: resourceBigipSysLogIPFIXUpdate,
+		DeleteContext: resourceBigipSysLogIPFIXDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the IPFIX log destination, in the form /Partition/Name",
+				ValidateFunc: validateF5Name,
+			},
+			"pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Pool of IPFIX collectors the BIG-IP sends log messages to",
+				ValidateFunc: validateF5Name,
+			},
+			"protocol_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Protocol used to send messages to the collectors, either `ipfix` or `netflow-v9`",
+				ValidateFunc: validation.StringInSlice([]string{"ipfix", "netflow-v9"}, false),
+			},
+			"template_delete_delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in seconds the BIG-IP waits before it deletes an unused template",
+			},
+			"template_retransmit_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Time in seconds between template retransmissions to the collectors",
+			},
+			"transport_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Transport profile (UDP, TCP or SCTP) used to reach the collectors",
+				ValidateFunc: validateF5Name,
+			},
+			"server_ssl_profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Server SSL profile used to encrypt traffic to the collectors",
+				ValidateFunc: validateF5Name,
+			},
+		},
+	}
+}
+
+func resourceBigipSysLogIPFIXCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating IPFIX Log Destination:%+v ", name)
+
+	config := getSysLogIPFIXConfig(d)
+	config.Name = name
+
+	err := createTmEntity(client, config, uriLogIPFIX)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create IPFIX Log Destination (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipSysLogIPFIXRead(ctx, d, meta)
+}
+
+func resourceBigipSysLogIPFIXRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching IPFIX Log Destination " + name)
+
+	var ipfix bigip.LogIPFIX
+	found, err := getTmEntity(client, &ipfix, uriLogIPFIX, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve IPFIX Log Destination (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] IPFIX Log Destination (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("pool_name", ipfix.PoolName)
+	_ = d.Set("protocol_version", ipfix.ProtocolVersion)
+	_ = d.Set("template_delete_delay", ipfix.TemplateDeleteDelay)
+	_ = d.Set("template_retransmit_interval", ipfix.TemplateRetransmitInterval)
+	_ = d.Set("transport_profile", ipfix.TransportProfile)
+	if err := d.Set("server_ssl_profile", noneToEmpty(ipfix.ServersslProfile)); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving server_ssl_profile to state for IPFIX Log Destination (%s): %s", name, err))
+	}
+	return nil
+}
+
+func resourceBigipSysLogIPFIXUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating IPFIX Log Destination:%+v ", name)
+
+	config := getSysLogIPFIXConfig(d)
+	err := modifyTmEntity(client, config, uriLogIPFIX, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify IPFIX Log Destination (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysLogIPFIXRead(ctx, d, meta)
+}
+
+func resourceBigipSysLogIPFIXDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting IPFIX Log Destination " + name)
+
+	err := deleteTmEntity(client, uriLogIPFIX, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete IPFIX Log Destination (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysLogIPFIXConfig(d *schema.ResourceData) *bigip.LogIPFIX {
+	return &bigip.LogIPFIX{
+		PoolName:                   d.Get("pool_name").(string),
+		ProtocolVersion:            d.Get("protocol_version").(string),
+		ServersslProfile:           emptyToNone(d.Get("server_ssl_profile").(string)),
+		TemplateDeleteDelay:        d.Get("template_delete_delay").(int),
+		TemplateRetransmitInterval: d.Get("template_retransmit_interval").(int),
+		TransportProfile:           d.Get("transport_profile").(string),
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestLogIPFIXName = fmt.Sprintf("/%s/test-log-ipfix", TestPartition)
+
+func testBigipSysLogIPFIXConfig(protocolVersion string, retransmit int) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "ipfix_collectors" {
+  name                = "/Common/test-ipfix-collectors"
+  load_balancing_mode = "round-robin"
+}
+resource "bigip_sys_log_ipfix" "test_ipfix" {
+  name                         = "%s"
+  pool_name                    = bigip_ltm_pool.ipfix_collectors.name
+  protocol_version             = "%s"
+  template_delete_delay        = 30
+  template_retransmit_interval = %d
+  transport_profile            = "/Common/udp"
+}
+`, TestLogIPFIXName, protocolVersion, retransmit)
+}
+
+func TestAccBigipSysLogIPFIXCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysLogIPFIXDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysLogIPFIXConfig("ipfix", 600),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogIPFIXExists(TestLogIPFIXName),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "name", TestLogIPFIXName),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "pool_name", "/Common/test-ipfix-collectors"),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "protocol_version", "ipfix"),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "template_delete_delay", "30"),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "template_retransmit_interval", "600"),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "transport_profile", "/Common/udp"),
+				),
+			},
+			{
+				Config: testBigipSysLogIPFIXConfig("netflow-v9", 300),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogIPFIXExists(TestLogIPFIXName),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "protocol_version", "netflow-v9"),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "template_retransmit_interval", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testBigipSysLogIPFIXServerSSLConfig(serverSSL string) string {
+	return fmt.Sprintf(`
+resource "bigip_ltm_pool" "ipfix_collectors" {
+  name                = "/Common/test-ipfix-collectors"
+  load_balancing_mode = "round-robin"
+}
+resource "bigip_sys_log_ipfix" "test_ipfix" {
+  name               = "%s"
+  pool_name          = bigip_ltm_pool.ipfix_collectors.name
+  transport_profile  = "/Common/tcp"
+  server_ssl_profile = %s
+}
+`, TestLogIPFIXName, serverSSL)
+}
+
+func TestAccBigipSysLogIPFIXServerSSL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysLogIPFIXDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysLogIPFIXServerSSLConfig(`"/Common/serverssl"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogIPFIXExists(TestLogIPFIXName),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "server_ssl_profile", "/Common/serverssl"),
+				),
+			},
+			{
+				Config: testBigipSysLogIPFIXServerSSLConfig("null"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysLogIPFIXExists(TestLogIPFIXName),
+					resource.TestCheckResourceAttr("bigip_sys_log_ipfix.test_ipfix", "server_ssl_profile", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysLogIPFIXImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysLogIPFIXDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysLogIPFIXConfig("ipfix", 600),
+			},
+			{
+				ResourceName:      "bigip_sys_log_ipfix.test_ipfix",
+				ImportStateId:     TestLogIPFIXName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckBigipSysLogIPFIXExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var ipfix bigip.LogIPFIX
+		found, err := getTmEntity(client, &ipfix, uriLogIPFIX, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("IPFIX Log Destination %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysLogIPFIXDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_log_ipfix" {
+			continue
+		}
+		var ipfix bigip.LogIPFIX
+		found, err := getTmEntity(client, &ipfix, uriLogIPFIX, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("IPFIX Log Destination %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
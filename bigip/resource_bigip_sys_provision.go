@@ -8,7 +8,9 @@ package bigip
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -139,3 +141,23 @@ func getsysProvisionConfig(d *schema.ResourceData, config *bigip.Provision) *big
 	config.MemoryRatio = d.Get("memory_ratio").(int)
 	return config
 }
+
+// checkModuleProvisioned returns an error unless the given module is
+// provisioned at a level other than none. go-bigip's Provisions only knows a
+// few modules, so sys/provision/<module> is read directly.
+func checkModuleProvisioned(client *bigip.BigIP, module string) error {
+	var p bigip.Provision
+	found, err := getTmEntity(client, &p, "sys/provision", module)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Provision (%s) (%v) ", module, err)
+		return err
+	}
+	if !found || p.Level == "" || p.Level == "none" {
+		level := "none"
+		if p.Level != "" {
+			level = p.Level
+		}
+		return fmt.Errorf("%s module is not provisioned, it is set to : (%s)", strings.ToUpper(module), level)
+	}
+	return nil
+}
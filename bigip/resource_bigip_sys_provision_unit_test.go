@@ -0,0 +1,54 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckModuleProvisioned(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/sys/provision/vcmp", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"vcmp","level":"dedicated"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/afm", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"name":"afm","level":"none"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/gtm", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested provision (gtm) was not found.","errorStack":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/provision/apm", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(w, `{"code":500,"message":"internal error","errorStack":[]}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	assert.NoError(t, checkModuleProvisioned(client, "vcmp"))
+	assert.EqualError(t, checkModuleProvisioned(client, "afm"), "AFM module is not provisioned, it is set to : (none)")
+	assert.EqualError(t, checkModuleProvisioned(client, "gtm"), "GTM module is not provisioned, it is set to : (none)")
+	assert.ErrorContains(t, checkModuleProvisioned(client, "apm"), "internal error")
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriRemoteRoleInfo = "auth/remote-role/role-info"
+
+type remoteRoleInfos struct {
+	RoleInfos []bigip.RoleInfo `json:"items"`
+}
+
+// The resource owns every role-info line on the device, the same way the
+// remote-role section of the BIG-IP GUI is edited as one ordered table.
+func resourceBigipSysRemoteRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysRemoteRoleCreate,
+		ReadContext:   resourceBigipSysRemoteRoleRead,
+		UpdateContext: resourceBigipSysRemoteRoleUpdate,
+		DeleteContext: resourceBigipSysRemoteRoleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"role_info": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Role-info lines mapping remote users or groups to a role and partition, evaluated by ascending line_order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the role-info line",
+						},
+						"line_order": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Position of the line, lower values are matched first",
+						},
+						"attribute": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Remote attribute the line matches, for example `memberOf=cn=bigip-admins,ou=groups,dc=example,dc=com`",
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "Role assigned to matching users",
+							ValidateFunc: validation.StringInSlice(sysUserRoles, false),
+						},
+						"user_partition": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Partition matching users get access to, or `All`",
+						},
+						"console": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Terminal access for matching users, `tmsh` or `disabled`",
+						},
+						"deny": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "Deny access to matching users instead of granting a role",
+							ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "User defined description",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSysRemoteRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Creating Remote Role lines")
+
+	for _, roleInfo := range getSysRemoteRoleConfig(d.Get("role_info").([]interface{})) {
+		ri := roleInfo
+		if err := client.CreateRoleInfo(&ri); err != nil {
+			log.Printf("[ERROR] Unable to Create Remote Role line (%s) (%v) ", ri.Name, err)
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("remote-role")
+	return resourceBigipSysRemoteRoleRead(ctx, d, meta)
+}
+
+func resourceBigipSysRemoteRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Fetching Remote Role lines")
+
+	var roleInfos remoteRoleInfos
+	if _, err := getTmEntity(client, &roleInfos, uriRemoteRoleInfo); err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Remote Role lines (%v) ", err)
+		return diag.FromErr(err)
+	}
+	// Lines already in state keep their position so the plan stays stable;
+	// lines created outside Terraform are appended by line order.
+	position := make(map[string]int)
+	for i, ri := range getSysRemoteRoleConfig(d.Get("role_info").([]interface{})) {
+		position[ri.Name] = i
+	}
+	sort.SliceStable(roleInfos.RoleInfos, func(i, j int) bool {
+		pi, iok := position[roleInfos.RoleInfos[i].Name]
+		pj, jok := position[roleInfos.RoleInfos[j].Name]
+		if iok && jok {
+			return pi < pj
+		}
+		if iok != jok {
+			return iok
+		}
+		return roleInfos.RoleInfos[i].LineOrder < roleInfos.RoleInfos[j].LineOrder
+	})
+	var lines []interface{}
+	for _, ri := range roleInfos.RoleInfos {
+		lines = append(lines, map[string]interface{}{
+			"name":           ri.Name,
+			"line_order":     ri.LineOrder,
+			"attribute":      ri.Attribute,
+			"role":           ri.Role,
+			"user_partition": ri.UserPartition,
+			"console":        ri.Console,
+			"deny":           ri.Deny,
+			"description":    ri.Description,
+		})
+	}
+	if err := d.Set("role_info", lines); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving role_info to state for Remote Role (%s): %s", d.Id(), err))
+	}
+	return nil
+}
+
+func resourceBigipSysRemoteRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Updating Remote Role lines")
+
+	o, n := d.GetChange("role_info")
+	current := make(map[string]bigip.RoleInfo)
+	for _, ri := range getSysRemoteRoleConfig(o.([]interface{})) {
+		current[ri.Name] = ri
+	}
+	desired := getSysRemoteRoleConfig(n.([]interface{}))
+	wanted := make(map[string]bigip.RoleInfo)
+	for _, ri := range desired {
+		wanted[ri.Name] = ri
+	}
+
+	// Line orders must be unique, so lines that go away or move are deleted
+	// before anything is created or modified.
+	for name, ri := range current {
+		if w, ok := wanted[name]; ok && w.LineOrder == ri.LineOrder {
+			continue
+		}
+		if err := client.DeleteRoleInfo(name); err != nil {
+			log.Printf("[ERROR] Unable to Delete Remote Role line (%s) (%v) ", name, err)
+			return diag.FromErr(err)
+		}
+		delete(current, name)
+	}
+	for _, roleInfo := range desired {
+		ri := roleInfo
+		var err error
+		if _, ok := current[ri.Name]; ok {
+			err = client.ModifyRoleInfo(ri.Name, &ri)
+		} else {
+			err = client.CreateRoleInfo(&ri)
+		}
+		if err != nil {
+			log.Printf("[ERROR] Unable to Modify Remote Role line (%s) (%v) ", ri.Name, err)
+			return diag.FromErr(err)
+		}
+	}
+	return resourceBigipSysRemoteRoleRead(ctx, d, meta)
+}
+
+func resourceBigipSysRemoteRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Deleting Remote Role lines")
+
+	for _, ri := range getSysRemoteRoleConfig(d.Get("role_info").([]interface{})) {
+		if err := client.DeleteRoleInfo(ri.Name); err != nil {
+			log.Printf("[ERROR] Unable to Delete Remote Role line (%s) (%v) ", ri.Name, err)
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysRemoteRoleConfig(lines []interface{}) []bigip.RoleInfo {
+	var roleInfos []bigip.RoleInfo
+	for _, line := range lines {
+		ri := line.(map[string]interface{})
+		roleInfos = append(roleInfos, bigip.RoleInfo{
+			Name:          ri["name"].(string),
+			LineOrder:     ri["line_order"].(int),
+			Attribute:     ri["attribute"].(string),
+			Role:          ri["role"].(string),
+			UserPartition: ri["user_partition"].(string),
+			Console:       ri["console"].(string),
+			Deny:          ri["deny"].(string),
+			Description:   ri["description"].(string),
+		})
+	}
+	return roleInfos
+}
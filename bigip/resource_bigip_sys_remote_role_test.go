@@ -0,0 +1,93 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysRemoteRoleResource = `
+resource "bigip_sys_remote_role" "test_remote_role" {
+  role_info {
+    name           = "tf-netops"
+    line_order     = %d
+    attribute      = "memberOf=cn=netops,ou=groups,dc=example,dc=com"
+    role           = "admin"
+    user_partition = "All"
+    console        = "tmsh"
+  }
+  role_info {
+    name           = "tf-tenant-a"
+    line_order     = %d
+    attribute      = "memberOf=cn=tenant-a,ou=groups,dc=example,dc=com"
+    role           = "manager"
+    user_partition = "Common"
+  }
+}
+`
+
+func TestAccBigipSysRemoteRoleCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysRemoteRoleDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(TestSysRemoteRoleResource, 100, 200),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysRemoteRoleExists("tf-netops"),
+					testCheckBigipSysRemoteRoleExists("tf-tenant-a"),
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.0.name", "tf-netops"),
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.1.role", "manager"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(TestSysRemoteRoleResource, 200, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.0.name", "tf-netops"),
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.0.line_order", "200"),
+					resource.TestCheckResourceAttr("bigip_sys_remote_role.test_remote_role", "role_info.1.line_order", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBigipSysRemoteRoleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		roleInfo, err := client.GetRoleInfo(name)
+		if err != nil {
+			return err
+		}
+		if roleInfo == nil {
+			return fmt.Errorf("Remote Role line %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysRemoteRoleDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	var roleInfos remoteRoleInfos
+	if _, err := getTmEntity(client, &roleInfos, uriRemoteRoleInfo); err != nil {
+		return err
+	}
+	for _, ri := range roleInfos.RoleInfos {
+		if ri.Name == "tf-netops" || ri.Name == "tf-tenant-a" {
+			return fmt.Errorf("Remote Role line %s not destroyed ", ri.Name)
+		}
+	}
+	return nil
+}
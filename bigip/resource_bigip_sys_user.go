@@ -0,0 +1,295 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriAuthUser = "auth/user"
+
+var sysUserRoles = []string{
+	"acceleration-policy-editor",
+	"admin",
+	"application-editor",
+	"auditor",
+	"certificate-manager",
+	"firewall-manager",
+	"fraud-protection-manager",
+	"guest",
+	"irule-manager",
+	"manager",
+	"no-access",
+	"operator",
+	"resource-admin",
+	"user-manager",
+	"web-application-security-administrator",
+	"web-application-security-editor",
+}
+
+type sysUserPartitionAccess struct {
+	Name string `json:"name,omitempty"`
+	Role string `json:"role,omitempty"`
+}
+
+type sysUser struct {
+	Name              string                   `json:"name,omitempty"`
+	Description       string                   `json:"description"`
+	Password          string                   `json:"password,omitempty"`
+	EncryptedPassword string                   `json:"encryptedPassword,omitempty"`
+	Shell             string                   `json:"shell,omitempty"`
+	PartitionAccess   []sysUserPartitionAccess `json:"partitionAccess,omitempty"`
+}
+
+func resourceBigipSysUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysUserCreate,
+		ReadContext:   resourceBigipSysUserRead,
+		UpdateContext: resourceBigipSysUserUpdate,
+		DeleteContext: resourceBigipSysUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the user account",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][\w.-]*$`), "user name may only contain letters, numbers or [._-]"),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User defined description, usually the full name of the account owner",
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"encrypted_password"},
+				Description:   "Password of the user account. Only a SHA1 hash of the value is kept in state",
+				StateFunc: func(v interface{}) string {
+					return hashForState(v.(string))
+				},
+			},
+			"encrypted_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password"},
+				Description:   "Password of the user account as a crypt(3) hash, for example the output of `openssl passwd -6`",
+			},
+			"shell": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				Description:  "Shell the user gets on SSH login, one of `tmsh`, `bash` or `none`",
+				ValidateFunc: validation.StringInSlice([]string{"tmsh", "bash", "none"}, false),
+			},
+			"partition_access": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Roles granted to the user, each scoped to a partition or to `all-partitions`",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partition": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Partition the role applies to, or `all-partitions`",
+							ValidateFunc: validatePartitionName,
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Role granted on the partition",
+							ValidateFunc: validation.StringInSlice(sysUserRoles, false),
+						},
+					},
+				},
+			},
+			"ssh_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Public keys written to the user's ~/.ssh/authorized_keys",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^[^'\"$`\\\\\n]+$"), "SSH key must be a single line without quotes, backticks, backslashes or `$`"),
+				},
+			},
+		},
+	}
+}
+
+func resourceBigipSysUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	log.Printf("[INFO] Creating User:%+v ", name)
+
+	config := getSysUserConfig(d)
+	config.Name = name
+	config.Password = d.Get("password").(string)
+	config.EncryptedPassword = d.Get("encrypted_password").(string)
+
+	err := createTmEntity(client, config, uriAuthUser)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create User (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+
+	if keys, ok := d.GetOk("ssh_keys"); ok {
+		if err := setSysUserSSHKeys(client, name, listToStringSlice(keys.([]interface{}))); err != nil {
+			return diag.FromErr(fmt.Errorf("error writing SSH keys for user %s: %v", name, err))
+		}
+	}
+	return resourceBigipSysUserRead(ctx, d, meta)
+}
+
+func resourceBigipSysUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching User " + name)
+
+	var user sysUser
+	found, err := getTmEntity(client, &user, uriAuthUser, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve User (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] User (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", user.Name)
+	_ = d.Set("description", user.Description)
+	_ = d.Set("shell", user.Shell)
+	if _, ok := d.GetOk("encrypted_password"); ok {
+		_ = d.Set("encrypted_password", user.EncryptedPassword)
+	}
+
+	var access []interface{}
+	for _, pa := range user.PartitionAccess {
+		access = append(access, map[string]interface{}{
+			"partition": pa.Name,
+			"role":      pa.Role,
+		})
+	}
+	if err := d.Set("partition_access", access); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving partition_access to state for User (%s): %s", name, err))
+	}
+
+	if _, ok := d.GetOk("ssh_keys"); ok {
+		keys, err := getSysUserSSHKeys(client, name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading SSH keys for user %s: %v", name, err))
+		}
+		_ = d.Set("ssh_keys", keys)
+	}
+	return nil
+}
+
+func resourceBigipSysUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Printf("[INFO] Updating User:%+v ", name)
+
+	config := getSysUserConfig(d)
+	// The password is only sent when its hash changed, so an unrelated update
+	// does not reset the password expiry on the device.
+	if d.HasChange("password") {
+		config.Password = d.Get("password").(string)
+	}
+	if d.HasChange("encrypted_password") {
+		config.EncryptedPassword = d.Get("encrypted_password").(string)
+	}
+	err := patchTmEntity(client, config, uriAuthUser, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Modify User (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("ssh_keys") {
+		if err := setSysUserSSHKeys(client, name, listToStringSlice(d.Get("ssh_keys").([]interface{}))); err != nil {
+			return diag.FromErr(fmt.Errorf("error writing SSH keys for user %s: %v", name, err))
+		}
+	}
+	return resourceBigipSysUserRead(ctx, d, meta)
+}
+
+func resourceBigipSysUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting User " + name)
+
+	err := deleteTmEntity(client, uriAuthUser, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Delete User (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getSysUserConfig(d *schema.ResourceData) *sysUser {
+	config := &sysUser{
+		Description: d.Get("description").(string),
+		Shell:       d.Get("shell").(string),
+	}
+	for _, item := range d.Get("partition_access").([]interface{}) {
+		pa := item.(map[string]interface{})
+		config.PartitionAccess = append(config.PartitionAccess, sysUserPartitionAccess{
+			Name: pa["partition"].(string),
+			Role: pa["role"].(string),
+		})
+	}
+	return config
+}
+
+// BIG-IP has no REST attribute for authorized keys, so they are managed in the
+// user's home directory through the bash utility.
+func setSysUserSSHKeys(client *bigip.BigIP, name string, keys []string) error {
+	dir := fmt.Sprintf("/home/%s/.ssh", name)
+	var content string
+	if len(keys) > 0 {
+		content = strings.Join(keys, "\n") + "\n"
+	}
+	cmd := fmt.Sprintf("-c 'mkdir -p %[1]s && printf \"%%s\" \"%[2]s\" > %[1]s/authorized_keys && chmod 700 %[1]s && chmod 600 %[1]s/authorized_keys && chown -R %[3]s %[1]s'",
+		dir, content, name)
+	_, err := client.RunCommand(&bigip.BigipCommand{
+		Command:     "run",
+		UtilCmdArgs: cmd,
+	})
+	return err
+}
+
+func getSysUserSSHKeys(client *bigip.BigIP, name string) ([]string, error) {
+	resp, err := client.RunCommand(&bigip.BigipCommand{
+		Command:     "run",
+		UtilCmdArgs: fmt.Sprintf("-c 'cat /home/%s/.ssh/authorized_keys 2>/dev/null'", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, line := range strings.Split(resp.CommandResult, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			keys = append(keys, line)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestSysUserName = "tf-tenant-admin"
+
+func testBigipSysUserConfig(password, role string) string {
+	return fmt.Sprintf(`
+resource "bigip_partition" "tenant" {
+  name        = "tf-user-tenant"
+  description = "tenant partition"
+}
+resource "bigip_sys_user" "tenant_admin" {
+  name        = "%s"
+  description = "tenant admin"
+  password    = "%s"
+  shell       = "tmsh"
+  partition_access {
+    partition = bigip_partition.tenant.name
+    role      = "%s"
+  }
+  partition_access {
+    partition = "Common"
+    role      = "guest"
+  }
+}
+`, TestSysUserName, password, role)
+}
+
+func TestAccBigipSysUserCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysUserDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUserConfig("F5site02@tf", "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysUserExists(TestSysUserName),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "name", TestSysUserName),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "shell", "tmsh"),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "password", hashForState("F5site02@tf")),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "partition_access.#", "2"),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "partition_access.0.partition", "tf-user-tenant"),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "partition_access.0.role", "admin"),
+				),
+			},
+			{
+				Config: testBigipSysUserConfig("F5site03@tf", "manager"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckBigipSysUserExists(TestSysUserName),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "password", hashForState("F5site03@tf")),
+					resource.TestCheckResourceAttr("bigip_sys_user.tenant_admin", "partition_access.0.role", "manager"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigipSysUserImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckBigipSysUserDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testBigipSysUserConfig("F5site02@tf", "admin"),
+			},
+			{
+				ResourceName:            "bigip_sys_user.tenant_admin",
+				ImportStateId:           TestSysUserName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testCheckBigipSysUserExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*bigip.BigIP)
+		var user sysUser
+		found, err := getTmEntity(client, &user, uriAuthUser, name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("User %s does not exist ", name)
+		}
+		return nil
+	}
+}
+
+func testCheckBigipSysUserDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_sys_user" {
+			continue
+		}
+		var user sysUser
+		found, err := getTmEntity(client, &user, uriAuthUser, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("User %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
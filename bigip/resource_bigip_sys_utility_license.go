@@ -0,0 +1,338 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const uriUtilityLicenses = "mgmt/cm/device/licensing/pool/utility/licenses"
+
+// go-bigip's ULIC helpers always use the first utility pool on BIG-IQ and a
+// fixed offering, so the pool is addressed by registration key here.
+type utilityLicensePool struct {
+	RegKey          string `json:"regKey"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	ExpiresDateTime string `json:"expiresDateTime"`
+}
+
+type utilityOffering struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type utilityOfferings struct {
+	Items []utilityOffering `json:"items"`
+}
+
+type utilityLicenseMember struct {
+	ID            string `json:"id,omitempty"`
+	DeviceAddress string `json:"deviceAddress,omitempty"`
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	HTTPSPort     int    `json:"httpsPort,omitempty"`
+	UnitOfMeasure string `json:"unitOfMeasure,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+type utilityLicenseMembers struct {
+	Items []utilityLicenseMember `json:"items"`
+}
+
+// bigiqConnectionSchema adds the BIG-IQ connection arguments, as used by
+// bigip_common_license_manage_bigiq, to a schema.
+func bigiqConnectionSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["bigiq_address"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Address of the BIG-IQ holding the utility license pool",
+	}
+	s["bigiq_port"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Management port of the BIG-IQ",
+	}
+	s["bigiq_user"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "User name on the BIG-IQ",
+	}
+	s["bigiq_password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Password of the BIG-IQ user",
+	}
+	s["bigiq_token_auth"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Enable to use an external authentication source (LDAP, TACACS, etc)",
+		DefaultFunc: schema.EnvDefaultFunc("BIGIQ_TOKEN_AUTH", true),
+	}
+	s["bigiq_login_ref"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Login reference for token authentication (see BIG-IQ REST docs for details)",
+		DefaultFunc: schema.EnvDefaultFunc("BIGIQ_LOGIN_REF", "local"),
+	}
+	return s
+}
+
+func resourceBigipSysUtilityLicense() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipSysUtilityLicenseCreate,
+		ReadContext:   resourceBigipSysUtilityLicenseRead,
+		UpdateContext: resourceBigipSysUtilityLicenseUpdate,
+		DeleteContext: resourceBigipSysUtilityLicenseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBigipSysUtilityLicenseImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: bigiqConnectionSchema(map[string]*schema.Schema{
+			"regkey": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Registration key of the utility license pool",
+			},
+			"offering": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the offering the license is taken from, e.g. `F5-BIG-MSP-BT-1G`",
+			},
+			"unit_of_measure": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "monthly", "yearly"}, false),
+				Description:  "Unit usage of the license is reported in",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the license assignment on BIG-IQ",
+			},
+		}),
+	}
+}
+
+func resourceBigipSysUtilityLicenseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	regKey := d.Get("regkey").(string)
+	log.Printf("[INFO] Creating Utility License for %s from pool:%+v ", client.Host, regKey)
+
+	bigiq, err := connectBigIq(d)
+	if err != nil {
+		log.Printf("[ERROR] Connection to BIG-IQ failed (%v) ", err)
+		return diag.FromErr(err)
+	}
+	offering, err := findUtilityOffering(bigiq, regKey, d.Get("offering").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	address, port, err := utilityLicenseDevice(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body := &utilityLicenseMember{
+		DeviceAddress: address,
+		Username:      client.User,
+		Password:      client.Password,
+		HTTPSPort:     port,
+		UnitOfMeasure: d.Get("unit_of_measure").(string),
+	}
+	resp, err := tmRequest(bigiq, "post", body, uriUtilityLicenses, regKey, "offerings", offering.ID, "members")
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create Utility License (%s) (%v) ", regKey, err)
+		return diag.FromErr(err)
+	}
+	var member utilityLicenseMember
+	if err := json.Unmarshal(resp, &member); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(strings.Join([]string{regKey, offering.ID, member.ID}, ":"))
+	if err := waitUtilityLicenseMember(ctx, bigiq, regKey, offering.ID, member.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBigipSysUtilityLicenseRead(ctx, d, meta)
+}
+
+func resourceBigipSysUtilityLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Println("[INFO] Fetching Utility License " + d.Id())
+
+	regKey, offeringID, memberID, err := parseUtilityLicenseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bigiq, err := connectBigIq(d)
+	if err != nil {
+		log.Printf("[ERROR] Connection to BIG-IQ failed (%v) ", err)
+		return diag.FromErr(err)
+	}
+	var member utilityLicenseMember
+	found, err := getTmEntity(bigiq, &member, uriUtilityLicenses, regKey, "offerings", offeringID, "members", memberID)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve Utility License (%s) (%v) ", d.Id(), err)
+		return diag.FromErr(err)
+	}
+	if !found {
+		log.Printf("[WARN] Utility License (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	var offering utilityOffering
+	if _, err := getTmEntity(bigiq, &offering, uriUtilityLicenses, regKey, "offerings", offeringID); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("regkey", regKey)
+	_ = d.Set("offering", offering.Name)
+	_ = d.Set("unit_of_measure", member.UnitOfMeasure)
+	_ = d.Set("status", member.Status)
+	return nil
+}
+
+// Only the BIG-IQ connection arguments can change in place, the license
+// assignment itself is left untouched.
+func resourceBigipSysUtilityLicenseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceBigipSysUtilityLicenseRead(ctx, d, meta)
+}
+
+func resourceBigipSysUtilityLicenseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	log.Println("[INFO] Revoking Utility License " + d.Id())
+
+	regKey, offeringID, memberID, err := parseUtilityLicenseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bigiq, err := connectBigIq(d)
+	if err != nil {
+		log.Printf("[ERROR] Connection to BIG-IQ failed (%v) ", err)
+		return diag.FromErr(err)
+	}
+	_, port, err := utilityLicenseDevice(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// BIG-IQ logs in to the BIG-IP again to remove the license from it.
+	body := &utilityLicenseMember{
+		ID:        memberID,
+		Username:  client.User,
+		Password:  client.Password,
+		HTTPSPort: port,
+	}
+	if _, err := tmRequest(bigiq, "delete", body, uriUtilityLicenses, regKey, "offerings", offeringID, "members", memberID); err != nil {
+		log.Printf("[ERROR] Unable to Revoke Utility License (%s) (%v) ", d.Id(), err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// The BIG-IQ connection is not part of the import ID, so it is taken from the
+// BIGIQ_ADDRESS, BIGIQ_PORT, BIGIQ_USER and BIGIQ_PASSWORD environment variables.
+func resourceBigipSysUtilityLicenseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseUtilityLicenseID(d.Id()); err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"address", "port", "user", "password"} {
+		_ = d.Set("bigiq_"+key, os.Getenv("BIGIQ_"+strings.ToUpper(key)))
+	}
+	_ = d.Set("bigiq_token_auth", os.Getenv("BIGIQ_TOKEN_AUTH") != "false")
+	loginRef := os.Getenv("BIGIQ_LOGIN_REF")
+	if loginRef == "" {
+		loginRef = "local"
+	}
+	_ = d.Set("bigiq_login_ref", loginRef)
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseUtilityLicenseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("utility license ID %q must have the format <regkey>:<offering id>:<member id>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// utilityLicenseDevice returns the address and port BIG-IQ uses to reach the
+// BIG-IP managed by the provider.
+func utilityLicenseDevice(client *bigip.BigIP) (string, int, error) {
+	uri := getDeviceUri(client.Host)
+	if len(uri) < 4 {
+		return "", 0, fmt.Errorf("unable to get the address of BIG-IP from %s", client.Host)
+	}
+	port := 443
+	if uri[3] != "" {
+		p, err := strconv.Atoi(uri[3])
+		if err != nil {
+			return "", 0, err
+		}
+		port = p
+	}
+	return uri[2], port, nil
+}
+
+func findUtilityOffering(bigiq *bigip.BigIP, regKey, name string) (*utilityOffering, error) {
+	var offerings utilityOfferings
+	found, err := getTmEntity(bigiq, &offerings, uriUtilityLicenses, regKey, "offerings")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("there is no utility license pool with registration key %s", regKey)
+	}
+	var names []string
+	for _, o := range offerings.Items {
+		if o.Name == name {
+			offering := o
+			return &offering, nil
+		}
+		names = append(names, o.Name)
+	}
+	return nil, fmt.Errorf("utility license pool %s has no offering %s, available offerings: %s", regKey, name, strings.Join(names, ", "))
+}
+
+func waitUtilityLicenseMember(ctx context.Context, bigiq *bigip.BigIP, regKey, offeringID, memberID string) error {
+	var member utilityLicenseMember
+	err := pollUntil(ctx, func() (bool, error) {
+		if _, err := getTmEntity(bigiq, &member, uriUtilityLicenses, regKey, "offerings", offeringID, "members", memberID); err != nil {
+			return false, err
+		}
+		switch {
+		case member.Status == "LICENSED":
+			return true, nil
+		case strings.HasSuffix(member.Status, "FAILED"):
+			return false, fmt.Errorf("utility license assignment %s: %s", member.Status, member.Message)
+		}
+		log.Printf("[DEBUG] Utility license member %s is %s, waiting", memberID, member.Status)
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for utility license member %s, it is still %s", memberID, member.Status)
+	}
+	return err
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The utility license tests need a BIG-IQ with a utility pool, set through
+// BIGIQ_ADDRESS, BIGIQ_USER, BIGIQ_PASSWORD, BIGIQ_REGKEY and BIGIQ_OFFERING.
+func testAccUtilityLicensePreCheck(t *testing.T) {
+	testAcctPreCheck(t)
+	for _, s := range [...]string{"BIGIQ_ADDRESS", "BIGIQ_USER", "BIGIQ_PASSWORD", "BIGIQ_REGKEY", "BIGIQ_OFFERING"} {
+		if os.Getenv(s) == "" {
+			t.Skipf("%s is not set, skipping utility license test", s)
+		}
+	}
+}
+
+func TestAccBigipSysUtilityLicenseCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccUtilityLicensePreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testSysUtilityLicenseResource("hourly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_utility_license.test-ulic", "status", "LICENSED"),
+					resource.TestCheckResourceAttr("bigip_sys_utility_license.test-ulic", "unit_of_measure", "hourly"),
+					resource.TestCheckResourceAttr("bigip_sys_utility_license.test-ulic", "offering", os.Getenv("BIGIQ_OFFERING")),
+					resource.TestCheckResourceAttr("data.bigip_sys_utility_pool.test-pool", "status", "READY"),
+					resource.TestCheckResourceAttrSet("data.bigip_sys_utility_pool.test-pool", "offerings.0.licensed_devices"),
+				),
+			},
+			{
+				Config: testSysUtilityLicenseResource("daily"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_sys_utility_license.test-ulic", "status", "LICENSED"),
+					resource.TestCheckResourceAttr("bigip_sys_utility_license.test-ulic", "unit_of_measure", "daily"),
+				),
+			},
+			{
+				ResourceName:            "bigip_sys_utility_license.test-ulic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bigiq_token_auth", "bigiq_login_ref"},
+			},
+		},
+	})
+}
+
+func testSysUtilityLicenseResource(unit string) string {
+	return fmt.Sprintf(`
+resource "bigip_sys_utility_license" "test-ulic" {
+  bigiq_address   = "%[1]s"
+  bigiq_user      = "%[2]s"
+  bigiq_password  = "%[3]s"
+  regkey          = "%[4]s"
+  offering        = "%[5]s"
+  unit_of_measure = "%[6]s"
+}
+
+data "bigip_sys_utility_pool" "test-pool" {
+  bigiq_address  = "%[1]s"
+  bigiq_user     = "%[2]s"
+  bigiq_password = "%[3]s"
+  regkey         = "%[4]s"
+  depends_on     = [bigip_sys_utility_license.test-ulic]
+}
+`, os.Getenv("BIGIQ_ADDRESS"), os.Getenv("BIGIQ_USER"), os.Getenv("BIGIQ_PASSWORD"), os.Getenv("BIGIQ_REGKEY"), os.Getenv("BIGIQ_OFFERING"), unit)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/stretchr/testify/assert"
+)
+
+const testUtilityRegKey = "ABCDE-FGHIJ-KLMNO-PQRST-UVWXYZA"
+
+func testUtilityLicenseClient() *bigip.BigIP {
+	return bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+}
+
+func TestParseUtilityLicenseID(t *testing.T) {
+	regKey, offering, member, err := parseUtilityLicenseID(testUtilityRegKey + ":offering-1:member-1")
+	assert.NoError(t, err)
+	assert.Equal(t, testUtilityRegKey, regKey)
+	assert.Equal(t, "offering-1", offering)
+	assert.Equal(t, "member-1", member)
+
+	for _, id := range []string{"", testUtilityRegKey, testUtilityRegKey + ":offering-1", testUtilityRegKey + "::member-1", "a:b:c:d"} {
+		_, _, _, err := parseUtilityLicenseID(id)
+		assert.Error(t, err, id)
+	}
+}
+
+func TestUtilityLicenseDevice(t *testing.T) {
+	data := map[string]struct {
+		address string
+		port    int
+	}{
+		"https://192.0.2.10":      {"192.0.2.10", 443},
+		"https://192.0.2.10:8443": {"192.0.2.10", 8443},
+	}
+	for host, expected := range data {
+		address, port, err := utilityLicenseDevice(&bigip.BigIP{Host: host})
+		assert.NoError(t, err)
+		assert.Equal(t, expected.address, address)
+		assert.Equal(t, expected.port, port)
+	}
+}
+
+func TestFindUtilityOffering(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey+"/offerings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		_, _ = fmt.Fprintf(w, `{"items":[{"id":"offering-1","name":"F5-BIG-MSP-BT-1G","status":"READY"},{"id":"offering-2","name":"F5-BIG-MSP-BT-10G","status":"READY"}]}`)
+	})
+	client := testUtilityLicenseClient()
+
+	offering, err := findUtilityOffering(client, testUtilityRegKey, "F5-BIG-MSP-BT-10G")
+	assert.NoError(t, err)
+	assert.Equal(t, "offering-2", offering.ID)
+
+	_, err = findUtilityOffering(client, testUtilityRegKey, "F5-BIG-MSP-LOADBAL-1G")
+	assert.EqualError(t, err, "utility license pool "+testUtilityRegKey+" has no offering F5-BIG-MSP-LOADBAL-1G, available offerings: F5-BIG-MSP-BT-1G, F5-BIG-MSP-BT-10G")
+}
+
+func TestWaitUtilityLicenseMember(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	polls := 0
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey+"/offerings/offering-1/members/member-1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "INSTALLING"
+		if polls == 3 {
+			status = "LICENSED"
+		}
+		_, _ = fmt.Fprintf(w, `{"id":"member-1","deviceAddress":"192.0.2.10","unitOfMeasure":"hourly","status":"%s"}`, status)
+	})
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey+"/offerings/offering-1/members/member-2", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"id":"member-2","status":"INSTALLATION_FAILED","message":"Device is not reachable"}`)
+	})
+	client := testUtilityLicenseClient()
+
+	assert.NoError(t, waitUtilityLicenseMember(context.Background(), client, testUtilityRegKey, "offering-1", "member-1"))
+	assert.Equal(t, 3, polls)
+
+	err := waitUtilityLicenseMember(context.Background(), client, testUtilityRegKey, "offering-1", "member-2")
+	assert.EqualError(t, err, "utility license assignment INSTALLATION_FAILED: Device is not reachable")
+
+	polls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	pollInterval = 50 * time.Millisecond
+	err = waitUtilityLicenseMember(ctx, client, testUtilityRegKey, "offering-1", "member-1")
+	assert.EqualError(t, err, "timed out waiting for utility license member member-1, it is still INSTALLING")
+}
+
+func TestGetUtilityPoolStatus(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"regKey":"%s","name":"payg","status":"READY","expiresDateTime":"2027-01-01T00:00:00-08:00"}`, testUtilityRegKey)
+	})
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey+"/offerings", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"id":"offering-1","name":"F5-BIG-MSP-BT-1G","status":"READY"}]}`)
+	})
+	mux.HandleFunc("/mgmt/cm/device/licensing/pool/utility/licenses/"+testUtilityRegKey+"/offerings/offering-1/members", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"id":"member-1","status":"LICENSED"},{"id":"member-2","status":"LICENSED"}]}`)
+	})
+
+	pool, offerings, err := getUtilityPoolStatus(testUtilityLicenseClient(), testUtilityRegKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "READY", pool.Status)
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"id":               "offering-1",
+		"name":             "F5-BIG-MSP-BT-1G",
+		"status":           "READY",
+		"licensed_devices": 2,
+	}}, offerings)
+}
@@ -7,9 +7,14 @@ package bigip
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"strings"
+	"time"
 
 	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +31,10 @@ func resourceBigipVcmpGuest() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: resourceBigipVcmpGuestCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -127,6 +136,30 @@ func resourceBigipVcmpGuest() *schema.Resource {
 				Computed:    true,
 				Description: "Virtual disk associated with vCMP guest.",
 			},
+			"upgrade": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Upgrade the guest in place when initial_image or initial_hotfix changes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pre_check_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+							Description:  "URL that must answer with a 2xx status before the guest is taken down.",
+						},
+						"post_check_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+							Description:  "URL that must answer with a 2xx status after the upgrade, polled until the update timeout.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -210,6 +243,17 @@ func resourceBigipVcmpGuestUpdate(ctx context.Context, d *schema.ResourceData, m
 	name := d.Id()
 	log.Printf("[INFO] Updating vCMP Guest:%+v", name)
 	p := dataToVcmp(name, d)
+	// A guest in configured state has no VM yet, so a new image is simply set.
+	oldState, _ := d.GetChange("state")
+	if upgrade := d.Get("upgrade").([]interface{}); len(upgrade) > 0 && d.HasChanges("initial_image", "initial_hotfix") && oldState.(string) != "configured" {
+		// An empty upgrade block, without health checks, is read as nil.
+		checks, _ := upgrade[0].(map[string]interface{})
+		if err := upgradeVcmpGuest(ctx, client, name, &p, checks["pre_check_url"], checks["post_check_url"]); err != nil {
+			log.Printf("[ERROR] Unable to Upgrade vCMP Guest  (%s) (%v) ", name, err)
+			return diag.FromErr(err)
+		}
+		return resourceBigipVcmpGuestRead(ctx, d, meta)
+	}
 	err := client.UpdateVcmpGuest(name, &p)
 	if err != nil {
 		log.Printf("[ERROR] Unable to Retrieve vCMP Guest  (%s) (%v) ", name, err)
@@ -241,7 +285,11 @@ func resourceBigipVcmpGuestDelete(ctx context.Context, d *schema.ResourceData, m
 
 func deleteVirtualDisk(d *schema.ResourceData, meta interface{}) error {
 	diskName, _ := d.Get("virtual_disk").(string)
-	client := meta.(*bigip.BigIP)
+	return deleteVcmpDisk(meta.(*bigip.BigIP), diskName)
+}
+
+// deleteVcmpDisk removes the copies of a virtual disk on every slot.
+func deleteVcmpDisk(client *bigip.BigIP, diskName string) error {
 	virtualDisks, err := client.GetVcmpDisks()
 	if err != nil {
 		return fmt.Errorf("error retrieving vCMP virtual disks: %v", err)
@@ -249,7 +297,7 @@ func deleteVirtualDisk(d *schema.ResourceData, meta interface{}) error {
 
 	diskFound := false
 	for _, disk := range virtualDisks.Disks {
-		if strings.HasPrefix(disk.Name, diskName) {
+		if disk.Name == diskName || strings.HasPrefix(disk.Name, diskName+"/") {
 			name := strings.Replace(disk.Name, "/", "~", 1)
 			err := client.DeleteVcmpDisk(name)
 			if err != nil {
@@ -263,3 +311,216 @@ func deleteVirtualDisk(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+// resourceBigipVcmpGuestCustomizeDiff checks at plan time that the host has the
+// slots and cores the guest asks for. The check is skipped when the host
+// inventory cannot be read, BIG-IP still rejects the guest on apply.
+func resourceBigipVcmpGuestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("cores_per_slot", "allowed_slots", "min_number_of_slots", "state") {
+		return nil
+	}
+	if !d.NewValueKnown("cores_per_slot") || !d.NewValueKnown("allowed_slots") || d.Get("cores_per_slot").(int) == 0 {
+		return nil
+	}
+	client, ok := meta.(*bigip.BigIP)
+	if !ok || client == nil {
+		return nil
+	}
+	host, err := getVcmpHost(client)
+	if err != nil {
+		log.Printf("[WARN] Unable to read vCMP host inventory, skipping capacity check (%v)", err)
+		return nil
+	}
+	state := d.Get("state").(string)
+	return validateVcmpGuestCapacity(host, d.Get("name").(string), d.Get("cores_per_slot").(int),
+		listToIntSlice(d.Get("allowed_slots").([]interface{})), d.Get("min_number_of_slots").(int), state != "" && state != "configured")
+}
+
+// validateVcmpGuestCapacity checks the requested cores against every slot the
+// guest may use. Free cores only matter once the guest is provisioned, the
+// cores the guest already holds count as free for itself.
+func validateVcmpGuestCapacity(host *vcmpHost, name string, coresPerSlot int, allowedSlots []int, minSlots int, deploy bool) error {
+	own := 0
+	for _, g := range host.Guests {
+		if (g.Name == name || g.FullPath == name) && g.State != "configured" {
+			own = g.CoresPerSlot
+		}
+	}
+	var known []int
+	slots := make(map[int]vcmpHostSlot)
+	for _, s := range host.Slots {
+		known = append(known, s.Slot)
+		slots[s.Slot] = s
+	}
+	candidates := allowedSlots
+	if len(candidates) == 0 {
+		candidates = known
+	}
+	if minSlots < 1 {
+		minSlots = 1
+	}
+
+	usable := 0
+	var free []string
+	for _, id := range candidates {
+		slot, ok := slots[id]
+		if !ok {
+			return fmt.Errorf("allowed slot %d does not exist on the vCMP host, slots are %v", id, known)
+		}
+		if coresPerSlot > slot.CoresTotal {
+			return fmt.Errorf("cores_per_slot %d exceeds the %d cores of slot %d", coresPerSlot, slot.CoresTotal, id)
+		}
+		available := slot.CoresTotal - slot.CoresUsed
+		if contains(slot.Guests, name) {
+			available += own
+		}
+		if available >= coresPerSlot {
+			usable++
+		}
+		free = append(free, fmt.Sprintf("slot %d: %d", id, available))
+	}
+	if deploy && usable < minSlots {
+		return fmt.Errorf("vCMP guest %s needs %d cores on at least %d slot(s), free cores are %s", name, coresPerSlot, minSlots, strings.Join(free, ", "))
+	}
+	return nil
+}
+
+// vcmpGuestDialTimeout limits each connection attempt to the management
+// address of an upgraded guest.
+var vcmpGuestDialTimeout = 5 * time.Second
+
+// upgradeVcmpGuest swaps the image of a guest: it is stopped by moving it to
+// provisioned, the new image and hotfix are set, and it is started again when
+// the configured state is deployed. All steps share the update timeout of ctx.
+func upgradeVcmpGuest(ctx context.Context, client *bigip.BigIP, name string, config *bigip.VcmpGuest, preCheck, postCheck interface{}) error {
+	if url, _ := preCheck.(string); url != "" {
+		if err := checkVcmpGuestHealth(ctx, url); err != nil {
+			return fmt.Errorf("pre-upgrade health check of vCMP guest %s failed, guest was not changed: %v", name, err)
+		}
+	}
+	log.Printf("[INFO] Stopping vCMP Guest %s for upgrade", name)
+	if err := client.UpdateVcmpGuest(name, &bigip.VcmpGuest{State: "provisioned"}); err != nil {
+		return err
+	}
+	if err := waitVcmpGuestStatus(ctx, client, name, "stopped"); err != nil {
+		return err
+	}
+
+	target := config.State
+	config.State = "provisioned"
+	log.Printf("[INFO] Setting image %s hotfix %s on vCMP Guest %s", config.InitialImage, config.InitialHotfix, name)
+	if err := client.UpdateVcmpGuest(name, config); err != nil {
+		return err
+	}
+	if target != "deployed" {
+		if target != "" && target != "provisioned" {
+			return client.UpdateVcmpGuest(name, &bigip.VcmpGuest{State: target})
+		}
+		return nil
+	}
+
+	log.Printf("[INFO] Deploying upgraded vCMP Guest %s", name)
+	if err := client.UpdateVcmpGuest(name, &bigip.VcmpGuest{State: "deployed"}); err != nil {
+		return err
+	}
+	if err := waitVcmpGuestStatus(ctx, client, name, "running"); err != nil {
+		return err
+	}
+	if config.ManagementIp != "" && config.ManagementIp != "none" {
+		if err := waitVcmpGuestReachable(ctx, config.ManagementIp); err != nil {
+			return fmt.Errorf("vCMP guest %s is running but not reachable: %v", name, err)
+		}
+	}
+	url, _ := postCheck.(string)
+	if url == "" {
+		return nil
+	}
+	var healthErr error
+	err := pollUntil(ctx, func() (bool, error) {
+		healthErr = checkVcmpGuestHealth(ctx, url)
+		if healthErr != nil {
+			log.Printf("[DEBUG] Post-upgrade health check of vCMP guest %s failed, retrying: %v", name, healthErr)
+		}
+		return healthErr == nil, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("post-upgrade health check of vCMP guest %s failed: %v", name, healthErr)
+	}
+	return err
+}
+
+// waitVcmpGuestStatus waits until the guest reports the VM status on every
+// slot it is assigned to.
+func waitVcmpGuestStatus(ctx context.Context, client *bigip.BigIP, name, status string) error {
+	var current []string
+	err := pollUntil(ctx, func() (bool, error) {
+		stats, err := client.GetVcmpGuestStats(name)
+		if err != nil {
+			return false, err
+		}
+		current = nil
+		done := stats != nil && len(stats.Entries) > 0
+		if stats != nil {
+			for _, entry := range stats.Entries {
+				vmStatus := entry.NestedStats.Entries.VmStatus.Descrption
+				if vmStatus == "failed" {
+					return false, fmt.Errorf("vCMP guest %s failed while waiting for it to be %s", name, status)
+				}
+				done = done && vmStatus == status
+				current = append(current, vmStatus)
+			}
+		}
+		if !done {
+			log.Printf("[DEBUG] vCMP guest %s is %s, waiting for %s", name, strings.Join(current, ", "), status)
+		}
+		return done, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for vCMP guest %s to be %s, it is %s", name, status, strings.Join(current, ", "))
+	}
+	return err
+}
+
+// waitVcmpGuestReachable waits until the management address of the guest
+// accepts connections on the HTTPS port.
+func waitVcmpGuestReachable(ctx context.Context, mgmtAddress string) error {
+	address := net.JoinHostPort(strings.Split(mgmtAddress, "/")[0], "443")
+	dialer := &net.Dialer{Timeout: vcmpGuestDialTimeout}
+	var dialErr error
+	err := pollUntil(ctx, func() (bool, error) {
+		var conn net.Conn
+		conn, dialErr = dialer.DialContext(ctx, "tcp", address)
+		if dialErr != nil {
+			return false, nil
+		}
+		return true, conn.Close()
+	})
+	if err != nil && dialErr != nil {
+		return dialErr
+	}
+	return err
+}
+
+// checkVcmpGuestHealth requests a health check URL, credentials can be given
+// in the URL. Guests use self-signed certificates, so they are not verified.
+func checkVcmpGuestHealth(ctx context.Context, url string) error {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("health check answered with HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
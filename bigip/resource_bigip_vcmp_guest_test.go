@@ -119,3 +119,48 @@ func testCheckvcmpguestDestroyed(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestAccBigipVcmpguest_upgrade(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckvcmpguestDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVcmpGuestUpgradeResource("BIGIP-16.1.4-0.0.47.iso"),
+				Check: resource.ComposeTestCheckFunc(
+					testBigipVcmpguestExists("tf-guest-upgrade", true),
+					resource.TestCheckResourceAttr("bigip_vcmp_guest.test-upgrade", "initial_image", "BIGIP-16.1.4-0.0.47.iso"),
+				),
+			},
+			{
+				Config: testVcmpGuestUpgradeResource("BIGIP-17.1.1-0.0.2.iso"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_vcmp_guest.test-upgrade", "initial_image", "BIGIP-17.1.1-0.0.2.iso"),
+					resource.TestCheckResourceAttr("bigip_vcmp_guest.test-upgrade", "state", "deployed"),
+				),
+			},
+		},
+	})
+}
+
+func testVcmpGuestUpgradeResource(image string) string {
+	return fmt.Sprintf(`
+resource "bigip_vcmp_guest" "test-upgrade" {
+  name           = "tf-guest-upgrade"
+  initial_image  = "%s"
+  mgmt_network   = "bridged"
+  mgmt_address   = "10.1.1.3/24"
+  state          = "deployed"
+  cores_per_slot = 2
+  upgrade {
+    post_check_url = "https://10.1.1.3/"
+  }
+  timeouts {
+    update = "45m"
+  }
+}
+`, image)
+}
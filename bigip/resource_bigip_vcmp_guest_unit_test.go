@@ -6,11 +6,17 @@ If a copy of the MPL was not distributed with this file, You can obtain one at h
 package bigip
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	bigip "github.com/f5devcentral/go-bigip"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
@@ -251,3 +257,195 @@ provider "bigip" {
 }
 `, resourceName, url)
 }
+
+func TestGetVcmpHost(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/sys/cluster/default", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"default","members":[{"name":"2","enabled":true},{"name":"1","enabled":true},{"name":"3","enabled":false}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/host-info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/host-info/0":{"nestedStats":{"entries":{"cpuCount":{"value":40},"hostId":{"description":"0"}}}}}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/guest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[
+{"name":"guest1","coresPerSlot":4,"assignedSlots":[1,2],"state":"deployed","virtualDisk":"guest1.img"},
+{"name":"guest2","coresPerSlot":8,"allowedSlots":[2],"state":"configured","virtualDisk":"guest2.img"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/software/image", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"BIGIP-17.1.0-0.0.16.iso","version":"17.1.0","build":"0.0.16"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/software/hotfix", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/virtual-disk", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"guest1.img/1"},{"name":"guest1.img/2"},{"name":"old-guest.img/2"}]}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	host, err := getVcmpHost(client)
+	assert.NoError(t, err)
+	assert.Equal(t, []vcmpHostSlot{
+		{Slot: 1, CoresTotal: 20, CoresUsed: 4, Guests: []string{"guest1"}},
+		{Slot: 2, CoresTotal: 20, CoresUsed: 4, Guests: []string{"guest1"}},
+	}, host.Slots)
+	assert.Equal(t, "17.1.0", host.Images[0].Version)
+	assert.Empty(t, host.Hotfixes)
+	assert.Equal(t, []vcmpHostDisk{
+		{Name: "guest1.img", Slot: 1, Guest: "guest1"},
+		{Name: "guest1.img", Slot: 2, Guest: "guest1"},
+		{Name: "old-guest.img", Slot: 2},
+	}, host.Disks)
+}
+
+func TestDataSourceBigipVcmpHostRead(t *testing.T) {
+	setup()
+	defer teardown()
+	mux.HandleFunc("/mgmt/tm/sys/provision/vcmp", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"name":"vcmp","level":"dedicated"}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/cluster/default", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprintf(w, `{"code":404,"message":"01020036:3: The requested cluster (default) was not found.","errorStack":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/host-info", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/sys/host-info/0":{"nestedStats":{"entries":{"cpuCount":{"value":16},"hostId":{"description":"0"}}}}}}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/guest", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"guest1","coresPerSlot":2,"assignedSlots":[1],"state":"deployed","virtualDisk":"guest1.img"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/software/image", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"BIGIP-17.1.0-0.0.16.iso","version":"17.1.0","build":"0.0.16"}]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/sys/software/hotfix", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[]}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/virtual-disk", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"items":[{"name":"guest1.img"}]}`)
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+
+	d := dataSourceBigipVcmpHost().TestResourceData()
+	diags := dataSourceBigipVcmpHostRead(context.Background(), d, client)
+	assert.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, 1, d.Get("slots.0.slot"))
+	assert.Equal(t, 8, d.Get("slots.0.cores_total"))
+	assert.Equal(t, 6, d.Get("slots.0.cores_available"))
+	assert.Equal(t, "guest1", d.Get("virtual_disks.0.guest"))
+	assert.Equal(t, "17.1.0", d.Get("images.0.version"))
+}
+
+func TestValidateVcmpGuestCapacity(t *testing.T) {
+	host := &vcmpHost{
+		Slots: []vcmpHostSlot{
+			{Slot: 1, CoresTotal: 8, CoresUsed: 6, Guests: []string{"guest1"}},
+			{Slot: 2, CoresTotal: 8, CoresUsed: 2, Guests: []string{"guest2"}},
+		},
+		Guests: []bigip.VcmpGuest{
+			{Name: "guest1", CoresPerSlot: 6, AssignedSlots: []int{1}, State: "deployed"},
+			{Name: "guest2", CoresPerSlot: 2, AssignedSlots: []int{2}, State: "provisioned"},
+		},
+	}
+	assert.NoError(t, validateVcmpGuestCapacity(host, "new", 4, nil, 1, true))
+	assert.NoError(t, validateVcmpGuestCapacity(host, "new", 4, []int{1}, 1, false))
+	assert.NoError(t, validateVcmpGuestCapacity(host, "guest1", 8, []int{1}, 1, true))
+	assert.EqualError(t, validateVcmpGuestCapacity(host, "new", 4, []int{3}, 1, true),
+		"allowed slot 3 does not exist on the vCMP host, slots are [1 2]")
+	assert.EqualError(t, validateVcmpGuestCapacity(host, "new", 10, nil, 1, false),
+		"cores_per_slot 10 exceeds the 8 cores of slot 1")
+	assert.EqualError(t, validateVcmpGuestCapacity(host, "new", 4, nil, 2, true),
+		"vCMP guest new needs 4 cores on at least 2 slot(s), free cores are slot 1: 2, slot 2: 6")
+	assert.EqualError(t, validateVcmpGuestCapacity(host, "new", 4, []int{1}, 1, true),
+		"vCMP guest new needs 4 cores on at least 1 slot(s), free cores are slot 1: 2")
+}
+
+func TestUpgradeVcmpGuest(t *testing.T) {
+	setup()
+	defer teardown()
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	var patches []string
+	vmStatus := "running"
+	mux.HandleFunc("/mgmt/tm/vcmp/guest/test-vcmp", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		body, _ := io.ReadAll(r.Body)
+		patches = append(patches, strings.TrimSpace(string(body)))
+		var guest bigip.VcmpGuest
+		_ = json.Unmarshal(body, &guest)
+		switch guest.State {
+		case "provisioned":
+			vmStatus = "stopped"
+		case "deployed":
+			vmStatus = "running"
+		}
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/guest/test-vcmp/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/vcmp/guest/test-vcmp/~Common~test-vcmp:1/stats":{"nestedStats":{"entries":{"vmStatus":{"description":"%s"}}}}}}`, vmStatus)
+	})
+	healthy := false
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	client := bigip.NewSession(&bigip.Config{
+		Address:  server.URL,
+		Username: "xxxx",
+		Password: "xxxx",
+		ConfigOptions: &bigip.ConfigOptions{
+			APICallTimeout: 5 * time.Second,
+			APICallRetries: 1,
+		},
+	})
+	healthURL := server.URL + "/health"
+
+	err := upgradeVcmpGuest(context.Background(), client, "test-vcmp", &bigip.VcmpGuest{Name: "test-vcmp", InitialImage: "17.1.0.iso", State: "deployed"}, healthURL, healthURL)
+	assert.EqualError(t, err, "pre-upgrade health check of vCMP guest test-vcmp failed, guest was not changed: health check answered with HTTP 503")
+	assert.Empty(t, patches)
+
+	healthy = true
+	err = upgradeVcmpGuest(context.Background(), client, "test-vcmp", &bigip.VcmpGuest{Name: "test-vcmp", InitialImage: "17.1.0.iso", State: "deployed"}, healthURL, healthURL)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`{"state":"provisioned"}`,
+		`{"name":"test-vcmp","initialImage":"17.1.0.iso","state":"provisioned"}`,
+		`{"state":"deployed"}`,
+	}, patches)
+
+	// An interrupted run stops waiting for the guest.
+	patches = nil
+	vmStatus = "running"
+	mux.HandleFunc("/mgmt/tm/vcmp/guest/stuck-vcmp", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{}`)
+	})
+	mux.HandleFunc("/mgmt/tm/vcmp/guest/stuck-vcmp/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"entries":{"https://localhost/mgmt/tm/vcmp/guest/stuck-vcmp/~Common~stuck-vcmp:1/stats":{"nestedStats":{"entries":{"vmStatus":{"description":"running"}}}}}}`)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = upgradeVcmpGuest(ctx, client, "stuck-vcmp", &bigip.VcmpGuest{Name: "stuck-vcmp", InitialImage: "17.1.0.iso", State: "deployed"}, nil, nil)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = upgradeVcmpGuest(ctx, client, "stuck-vcmp", &bigip.VcmpGuest{Name: "stuck-vcmp", InitialImage: "17.1.0.iso", State: "deployed"}, nil, nil)
+	assert.EqualError(t, err, "timed out waiting for vCMP guest stuck-vcmp to be stopped, it is running")
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var vcmpDiskNameRegexp = regexp.MustCompile(`^[\w.-]+\.img$`)
+
+func resourceBigipVcmpVirtualDisk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBigipVcmpVirtualDiskCreate,
+		ReadContext:   resourceBigipVcmpVirtualDiskRead,
+		DeleteContext: resourceBigipVcmpVirtualDiskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "File name of the virtual disk, a guest named `guest1` uses `guest1.img`",
+				ValidateFunc: validation.StringMatch(vcmpDiskNameRegexp, "virtual disk name must end in .img and may only contain letters, numbers or [._-]"),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Existing virtual disk the disk is copied from",
+				ValidateFunc: validation.StringMatch(vcmpDiskNameRegexp, "virtual disk name must end in .img and may only contain letters, numbers or [._-]"),
+			},
+			"slots": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Slots holding a copy of the virtual disk",
+			},
+			"guest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Guest using the virtual disk, empty when the disk is not in use",
+			},
+		},
+	}
+}
+
+// Virtual disks cannot be created through iControl REST, the disk is staged by
+// copying source in /shared/vmdisks. Disks that already exist are managed by
+// importing them.
+func resourceBigipVcmpVirtualDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Get("name").(string)
+	source := d.Get("source").(string)
+	log.Printf("[INFO] Creating vCMP Virtual Disk:%+v ", name)
+
+	if source == "" {
+		return diag.FromErr(fmt.Errorf("vCMP virtual disk %s cannot be created without source, import it if it already exists", name))
+	}
+	disks, err := getVcmpVirtualDisks(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(filterVcmpDisks(disks, name)) > 0 {
+		return diag.FromErr(fmt.Errorf("vCMP virtual disk %s already exists, import it to manage it", name))
+	}
+	if len(filterVcmpDisks(disks, source)) == 0 {
+		return diag.FromErr(fmt.Errorf("source vCMP virtual disk %s does not exist", source))
+	}
+	_, err = client.RunCommand(&bigip.BigipCommand{
+		Command:     "run",
+		UtilCmdArgs: fmt.Sprintf("-c 'cp --sparse=always /shared/vmdisks/%s /shared/vmdisks/%s'", source, name),
+	})
+	if err != nil {
+		log.Printf("[ERROR] Unable to Create vCMP Virtual Disk (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId(name)
+	return resourceBigipVcmpVirtualDiskRead(ctx, d, meta)
+}
+
+func resourceBigipVcmpVirtualDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Fetching vCMP Virtual Disk " + name)
+
+	disks, err := getVcmpVirtualDisks(client)
+	if err != nil {
+		log.Printf("[ERROR] Unable to Retrieve vCMP Virtual Disk (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	copies := filterVcmpDisks(disks, name)
+	if len(copies) == 0 {
+		log.Printf("[WARN] vCMP Virtual Disk (%s) not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+	var slots []int
+	guest := ""
+	for _, disk := range copies {
+		slots = append(slots, disk.Slot)
+		if disk.Guest != "" {
+			guest = disk.Guest
+		}
+	}
+	_ = d.Set("name", name)
+	_ = d.Set("slots", slots)
+	_ = d.Set("guest", guest)
+	return nil
+}
+
+func resourceBigipVcmpVirtualDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+	name := d.Id()
+	log.Println("[INFO] Deleting vCMP Virtual Disk " + name)
+
+	if guest := d.Get("guest").(string); guest != "" {
+		return diag.FromErr(fmt.Errorf("vCMP virtual disk %s is in use by guest %s", name, guest))
+	}
+	if err := deleteVcmpDisk(client, name); err != nil {
+		log.Printf("[ERROR] Unable to Delete vCMP Virtual Disk (%s) (%v) ", name, err)
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func getVcmpVirtualDisks(client *bigip.BigIP) ([]vcmpHostDisk, error) {
+	var guests vcmpGuests
+	if _, err := getTmEntity(client, &guests, uriVcmpGuest); err != nil {
+		return nil, err
+	}
+	disks, err := client.GetVcmpDisks()
+	if err != nil {
+		return nil, err
+	}
+	var result []vcmpHostDisk
+	if disks != nil {
+		for _, disk := range disks.Disks {
+			result = append(result, parseVcmpDisk(disk.Name, guests.Items))
+		}
+	}
+	return result, nil
+}
+
+func filterVcmpDisks(disks []vcmpHostDisk, name string) []vcmpHostDisk {
+	var result []vcmpHostDisk
+	for _, disk := range disks {
+		if disk.Name == name {
+			result = append(result, disk)
+		}
+	}
+	return result
+}
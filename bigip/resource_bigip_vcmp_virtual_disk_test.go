@@ -0,0 +1,90 @@
+/*
+Copyright 2024 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file, You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var TestVcmpVirtualDiskName = "tf-guest-staged.img"
+
+func TestAccBigipVcmpVirtualDiskCreate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAcctPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckVcmpVirtualDisksDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testVcmpVirtualDiskResource(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bigip_vcmp_virtual_disk.test-disk", "name", TestVcmpVirtualDiskName),
+					resource.TestCheckResourceAttr("bigip_vcmp_virtual_disk.test-disk", "guest", ""),
+					resource.TestCheckResourceAttrSet("bigip_vcmp_virtual_disk.test-disk", "slots.0"),
+					resource.TestCheckResourceAttrSet("data.bigip_vcmp_host.test-host", "slots.0.cores_available"),
+					resource.TestCheckResourceAttrSet("data.bigip_vcmp_host.test-host", "images.0.name"),
+				),
+			},
+			{
+				Config:      testVcmpVirtualDiskResource(512),
+				ExpectError: regexp.MustCompile("cores_per_slot 512 exceeds the [0-9]+ cores of slot"),
+			},
+			{
+				ResourceName:            "bigip_vcmp_virtual_disk.test-disk",
+				ImportStateId:           TestVcmpVirtualDiskName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func testCheckVcmpVirtualDisksDestroyed(s *terraform.State) error {
+	client := testAccProvider.Meta().(*bigip.BigIP)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "bigip_vcmp_virtual_disk" {
+			continue
+		}
+		disks, err := getVcmpVirtualDisks(client)
+		if err != nil {
+			return err
+		}
+		if len(filterVcmpDisks(disks, rs.Primary.ID)) > 0 {
+			return fmt.Errorf("vCMP virtual disk %s not destroyed ", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testVcmpVirtualDiskResource(cores int) string {
+	return fmt.Sprintf(`
+resource "bigip_vcmp_guest" "test-guest" {
+  name           = "tf-guest-golden"
+  initial_image  = "12.1.2.iso"
+  mgmt_network   = "bridged"
+  mgmt_address   = "10.1.1.2/24"
+  state          = "provisioned"
+  cores_per_slot = %d
+}
+
+resource "bigip_vcmp_virtual_disk" "test-disk" {
+  name   = "%s"
+  source = bigip_vcmp_guest.test-guest.virtual_disk
+}
+
+data "bigip_vcmp_host" "test-host" {
+  depends_on = [bigip_vcmp_virtual_disk.test-disk]
+}
+`, cores, TestVcmpVirtualDiskName)
+}